@@ -0,0 +1,118 @@
+// Command github-import adds the issues dumped from the GitHub REST API to a project as tasks.
+//
+//	github-import -project id [-comments comments.json] [-open Todo] [-closed Done] [-dry-run] issues.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	columnRepository "github.com/igkostyuk/tasktracker/column/repository/postgres"
+	commentRepository "github.com/igkostyuk/tasktracker/comment/repository/postgres"
+	"github.com/igkostyuk/tasktracker/configs"
+	"github.com/igkostyuk/tasktracker/internal/github"
+	linkRepository "github.com/igkostyuk/tasktracker/link/repository/postgres"
+	projectRepository "github.com/igkostyuk/tasktracker/project/repository/postgres"
+	projectUsecase "github.com/igkostyuk/tasktracker/project/usecase"
+	"github.com/igkostyuk/tasktracker/store/postgres"
+	taskRepository "github.com/igkostyuk/tasktracker/task/repository/postgres"
+	taskUsecase "github.com/igkostyuk/tasktracker/task/usecase"
+	templateRepository "github.com/igkostyuk/tasktracker/template/repository/postgres"
+)
+
+type options struct {
+	project  string
+	comments string
+	columns  github.Columns
+	dryRun   bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.project, "project", "", "id of the project the tasks are added to")
+	flag.StringVar(&opts.comments, "comments", "", "issue comments JSON dump")
+	flag.StringVar(&opts.columns.Open, "open", "Todo", "column of the open issues, created when missing")
+	flag.StringVar(&opts.columns.Closed, "closed", "Done", "column of the closed issues, created when missing")
+	flag.BoolVar(&opts.dryRun, "dry-run", false, "report what would be created without changing the database")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s -project id [flags] issues.json\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || opts.project == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), &opts); err != nil {
+		log.Fatalf("github-import: %v", err)
+	}
+}
+
+func run(path string, opts *options) error {
+	projectID, err := uuid.Parse(opts.project)
+	if err != nil {
+		return fmt.Errorf("project id: %w", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open issues: %w", err)
+	}
+	defer f.Close()
+	issues, err := github.ParseIssues(f)
+	if err != nil {
+		return err
+	}
+	var comments []github.Comment
+	if opts.comments != "" {
+		cf, err := os.Open(opts.comments)
+		if err != nil {
+			return fmt.Errorf("open comments: %w", err)
+		}
+		defer cf.Close()
+		if comments, err = github.ParseComments(cf); err != nil {
+			return err
+		}
+	}
+	plan := github.NewPlan(issues, comments)
+	fmt.Printf("%d open issues to %q, %d closed issues to %q, %d comments, %d pull requests skipped\n",
+		len(plan.Open), opts.columns.Open, len(plan.Closed), opts.columns.Closed, plan.Comments(), plan.Skipped)
+	if opts.dryRun {
+		fmt.Println("dry run: nothing is created")
+
+		return nil
+	}
+
+	cfg, err := configs.FromFile("")
+	if err != nil {
+		return fmt.Errorf("parsing config: %w", err)
+	}
+	db, err := postgres.Open(cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("connecting to db: %w", err)
+	}
+	defer db.Close()
+
+	columnRepo := columnRepository.New(db)
+	commentRepo := commentRepository.New(db)
+	projectRepo := projectRepository.New(db)
+	taskRepo := taskRepository.New(db)
+	transitionRepo := taskRepository.NewTransition(db)
+	tasks := taskUsecase.New(columnRepo, taskRepo, commentRepo, linkRepository.New(db), projectRepo)
+	importer := github.New(
+		projectUsecase.New(
+			projectRepo, columnRepo, taskRepo, transitionRepo, templateRepository.New(db), commentRepo, tasks,
+		),
+		tasks,
+		commentRepo,
+	)
+	if err = importer.Import(context.Background(), projectID, opts.columns, &plan); err != nil {
+		return err
+	}
+	fmt.Printf("imported into project %s\n", projectID)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"expvar"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 
 	// nolint:gosec //Register the pprof handlers on other port than api.
@@ -16,8 +17,12 @@ import (
 	"syscall"
 
 	"github.com/igkostyuk/tasktracker/app/server"
+	columnRepository "github.com/igkostyuk/tasktracker/column/repository/postgres"
 	"github.com/igkostyuk/tasktracker/configs"
+	"github.com/igkostyuk/tasktracker/internal/purge"
+	projectRepository "github.com/igkostyuk/tasktracker/project/repository/postgres"
 	"github.com/igkostyuk/tasktracker/store/postgres"
+	taskRepository "github.com/igkostyuk/tasktracker/task/repository/postgres"
 	"go.uber.org/zap"
 )
 
@@ -75,6 +80,14 @@ func run(cfgPath string, logger *zap.Logger) error {
 		}
 	}()
 	// =========================================================================
+	// Start Purge Job
+	// Removes the items kept in the trash longer than the retention period.
+	logger.Info("main: Initializing purge job")
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go purge.Run(purgeCtx, logger, cfg.Trash.PurgeInterval, cfg.Trash.Retention,
+		taskRepository.New(db), columnRepository.New(db), projectRepository.New(db))
+	// =========================================================================
 	// Start API Service
 	logger.Info("main: Initializing API support")
 	// Make a channel to listen for an interrupt or terminate signal from the OS.
@@ -82,13 +95,25 @@ func run(cfgPath string, logger *zap.Logger) error {
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
 	api := server.New(cfg, logger, db)
-	serverErrors := make(chan error, 1)
+	serverErrors := make(chan error, 2)
 	// Start the service listening for requests.
 	go func() {
 		logger.Sugar().Infof("main: API listening on %s", api.Addr)
 		serverErrors <- api.ListenAndServe()
 	}()
 	// =========================================================================
+	// Start gRPC Service
+	logger.Info("main: Initializing gRPC support")
+	rpc := server.NewGRPC(cfg, logger, db)
+	listener, err := net.Listen("tcp", cfg.GRPCHost)
+	if err != nil {
+		return fmt.Errorf("listening grpc: %w", err)
+	}
+	go func() {
+		logger.Sugar().Infof("main: gRPC listening on %s", cfg.GRPCHost)
+		serverErrors <- rpc.Serve(listener)
+	}()
+	// =========================================================================
 	// Shutdown
 	select { // Blocking main and waiting for shutdown.
 	case err := <-serverErrors:
@@ -98,8 +123,20 @@ func run(cfgPath string, logger *zap.Logger) error {
 		// Give outstanding requests a deadline for completion.
 		ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 		defer cancel()
+		// Stop accepting RPCs and wait for the pending ones until the deadline.
+		stopped := make(chan struct{})
+		go func() {
+			rpc.GracefulStop()
+			close(stopped)
+		}()
 		// Asking listener to shutdown and shed load.
-		if err := api.Shutdown(ctx); err != nil {
+		err := api.Shutdown(ctx)
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			rpc.Stop()
+		}
+		if err != nil {
 			api.Close()
 
 			return fmt.Errorf("could not stop server gracefully: %w", err)
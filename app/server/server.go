@@ -13,41 +13,90 @@ import (
 	commentUsecase "github.com/igkostyuk/tasktracker/comment/usecase"
 	"github.com/igkostyuk/tasktracker/configs"
 	"github.com/igkostyuk/tasktracker/docs"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/graphql"
 	"github.com/igkostyuk/tasktracker/internal/middleware"
+	"github.com/igkostyuk/tasktracker/internal/report"
+	"github.com/igkostyuk/tasktracker/internal/rpc"
+	linkRepository "github.com/igkostyuk/tasktracker/link/repository/postgres"
 	projectDelivery "github.com/igkostyuk/tasktracker/project/delivery/http"
 	projectRepository "github.com/igkostyuk/tasktracker/project/repository/postgres"
 	projectUsecase "github.com/igkostyuk/tasktracker/project/usecase"
 	taskDelivery "github.com/igkostyuk/tasktracker/task/delivery/http"
 	taskRepository "github.com/igkostyuk/tasktracker/task/repository/postgres"
 	taskUsecase "github.com/igkostyuk/tasktracker/task/usecase"
+	templateDelivery "github.com/igkostyuk/tasktracker/template/delivery/http"
+	templateRepository "github.com/igkostyuk/tasktracker/template/repository/postgres"
+	templateUsecase "github.com/igkostyuk/tasktracker/template/usecase"
 	"github.com/rs/cors"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 // @title Task Tracker API
 // @version 1.0
 // @BasePath /v1
 
-// New constructs an http.Server with main routes.
-func New(cfg configs.Config, logger *zap.Logger, db *sql.DB) *http.Server {
-	r := chi.NewRouter()
+// usecases represent the usecases shared by the HTTP and gRPC servers.
+type usecases struct {
+	projects  domain.ProjectUsecase
+	columns   domain.ColumnUsecase
+	tasks     domain.TaskUsecase
+	comments  domain.CommentUsecase
+	templates domain.TemplateUsecase
+}
 
+func newUsecases(cfg configs.Config, db *sql.DB) usecases {
 	columnRepo := columnRepository.New(db)
 	commentRepo := commentRepository.New(db)
+	linkRepo := linkRepository.New(db)
 	projectRepo := projectRepository.New(db)
 	taskRepo := taskRepository.New(db)
+	transitionRepo := taskRepository.NewTransition(db)
+	templateRepo := templateRepository.New(db)
+
+	var taskOptions []taskUsecase.Option
+	if cfg.Tasks.BlockDone {
+		taskOptions = append(taskOptions, taskUsecase.WithBlockedDone())
+	}
+	tasks := taskUsecase.New(columnRepo, taskRepo, commentRepo, linkRepo, projectRepo, taskOptions...)
+
+	return usecases{
+		projects: projectUsecase.New(
+			projectRepo, columnRepo, taskRepo, transitionRepo, templateRepo, commentRepo, tasks,
+		),
+		columns:   columnUsecase.New(columnRepo, taskRepo, projectRepo),
+		tasks:     tasks,
+		comments:  commentUsecase.New(commentRepo, projectRepo),
+		templates: templateUsecase.New(templateRepo),
+	}
+}
+
+// New constructs an http.Server with main routes.
+func New(cfg configs.Config, logger *zap.Logger, db *sql.DB) *http.Server {
+	r := chi.NewRouter()
+	u := newUsecases(cfg, db)
+
+	reports, err := report.New(cfg.Report.TemplatesDir)
+	if err != nil {
+		logger.Error("loading report templates, using the built-in ones", zap.Error(err))
+		reports = report.Default()
+	}
 
 	r.Route("/v1", func(r chi.Router) {
 		r.Use(
 			middleware.RequestID,
 			middleware.NewZaplogger(logger),
 			middleware.Recoverer,
+			middleware.Admin(cfg.AdminToken),
 		)
-		r.Mount("/projects", projectDelivery.New(projectUsecase.New(projectRepo, columnRepo, taskRepo)))
-		r.Mount("/columns", columnDelivery.New(columnUsecase.New(columnRepo, taskRepo)))
-		r.Mount("/tasks", taskDelivery.New(taskUsecase.New(columnRepo, taskRepo, commentRepo)))
-		r.Mount("/comments", commentDelivery.New(commentUsecase.New(commentRepo)))
+		r.Mount("/projects", projectDelivery.New(u.projects, projectDelivery.WithReports(reports)))
+		r.Mount("/columns", columnDelivery.New(u.columns))
+		r.Mount("/tasks", taskDelivery.New(u.tasks))
+		r.Mount("/comments", commentDelivery.New(u.comments))
+		r.Mount("/templates", templateDelivery.New(u.templates))
+		r.Handle("/graphql", graphql.New(u.projects, u.columns, u.tasks, u.comments))
 	})
 
 	docs.SwaggerInfo.Host = cfg.APIHost
@@ -61,3 +110,10 @@ func New(cfg configs.Config, logger *zap.Logger, db *sql.DB) *http.Server {
 		WriteTimeout: cfg.WriteTimeout,
 	}
 }
+
+// NewGRPC constructs a grpc.Server with the services of the main resources.
+func NewGRPC(cfg configs.Config, logger *zap.Logger, db *sql.DB) *grpc.Server {
+	u := newUsecases(cfg, db)
+
+	return rpc.New(logger, u.projects, u.columns, u.tasks, u.comments)
+}
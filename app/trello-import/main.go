@@ -0,0 +1,104 @@
+// Command trello-import creates a project from a Trello board JSON export.
+//
+//	trello-import [-dry-run] board.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	columnRepository "github.com/igkostyuk/tasktracker/column/repository/postgres"
+	columnUsecase "github.com/igkostyuk/tasktracker/column/usecase"
+	commentRepository "github.com/igkostyuk/tasktracker/comment/repository/postgres"
+	"github.com/igkostyuk/tasktracker/configs"
+	"github.com/igkostyuk/tasktracker/internal/trello"
+	linkRepository "github.com/igkostyuk/tasktracker/link/repository/postgres"
+	projectRepository "github.com/igkostyuk/tasktracker/project/repository/postgres"
+	projectUsecase "github.com/igkostyuk/tasktracker/project/usecase"
+	"github.com/igkostyuk/tasktracker/store/postgres"
+	taskRepository "github.com/igkostyuk/tasktracker/task/repository/postgres"
+	taskUsecase "github.com/igkostyuk/tasktracker/task/usecase"
+	templateRepository "github.com/igkostyuk/tasktracker/template/repository/postgres"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report what would be created without changing the database")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-dry-run] board.json\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *dryRun); err != nil {
+		log.Fatalf("trello-import: %v", err)
+	}
+}
+
+func run(path string, dryRun bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open board: %w", err)
+	}
+	defer f.Close()
+	board, err := trello.Parse(f)
+	if err != nil {
+		return err
+	}
+	plan := trello.NewPlan(&board)
+	report(&plan)
+	if dryRun {
+		fmt.Println("dry run: nothing is created")
+
+		return nil
+	}
+
+	cfg, err := configs.FromFile("")
+	if err != nil {
+		return fmt.Errorf("parsing config: %w", err)
+	}
+	db, err := postgres.Open(cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("connecting to db: %w", err)
+	}
+	defer db.Close()
+
+	columnRepo := columnRepository.New(db)
+	commentRepo := commentRepository.New(db)
+	projectRepo := projectRepository.New(db)
+	taskRepo := taskRepository.New(db)
+	transitionRepo := taskRepository.NewTransition(db)
+	tasks := taskUsecase.New(columnRepo, taskRepo, commentRepo, linkRepository.New(db), projectRepo)
+	importer := trello.New(
+		projectUsecase.New(
+			projectRepo, columnRepo, taskRepo, transitionRepo, templateRepository.New(db), commentRepo, tasks,
+		),
+		columnUsecase.New(columnRepo, taskRepo, projectRepo),
+		tasks,
+	)
+	project, err := importer.Import(context.Background(), &plan)
+	if err != nil {
+		if project.ID != uuid.Nil {
+			return fmt.Errorf("project %s is partially imported: %w", project.ID, err)
+		}
+
+		return err
+	}
+	fmt.Printf("created project %s\n", project.ID)
+
+	return nil
+}
+
+func report(plan *trello.Plan) {
+	fmt.Printf("project %q: %d columns, %d tasks, %d comments, %d archived lists and cards skipped\n",
+		plan.Project.Name, len(plan.Columns), plan.Tasks(), plan.Comments(), plan.Skipped)
+	for _, c := range plan.Columns {
+		fmt.Printf("  column %q: %d tasks\n", c.Name, len(c.Tasks))
+	}
+}
@@ -0,0 +1,33 @@
+// Command tt is the command line client of the task tracker API for the daily board operations.
+//
+//	tt [-config file] [-url url] [-token token] [-json] command [args]
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/igkostyuk/tasktracker/internal/cli"
+)
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+	err := cli.Run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	cancel()
+	if errors.Is(err, cli.ErrUsage) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tt: %v\n", err)
+		os.Exit(1)
+	}
+}
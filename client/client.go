@@ -0,0 +1,268 @@
+// Package client is a Go client of the task tracker REST API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The media types and headers of the API.
+const (
+	mergePatchType   = "application/merge-patch+json"
+	adminTokenHeader = "X-Admin-Token"
+)
+
+// The defaults of the retries of the idempotent requests.
+const (
+	defaultRetries = 2
+	defaultBackoff = 100 * time.Millisecond
+)
+
+// Client calls the endpoints of the API served at its base URL.
+// The methods of a Client are safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	adminToken string
+	retries    int
+	backoff    time.Duration
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient makes the client send the requests with hc instead of http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithAdminToken authenticates the requests with the admin token of the API.
+func WithAdminToken(token string) Option {
+	return func(c *Client) {
+		c.adminToken = token
+	}
+}
+
+// WithRetries sets how many times a failed idempotent request is retried,
+// the wait before a retry starts at backoff and doubles with every attempt.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// New will create new a Client of the API served at baseURL, e.g. http://localhost:3000.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse base url: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("base url %q must be absolute", baseURL)
+	}
+	c := &Client{
+		baseURL:    u,
+		httpClient: http.DefaultClient,
+		adminToken: "",
+		retries:    defaultRetries,
+		backoff:    defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// request represent a call of an endpoint.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+}
+
+func newRequest(method string, query url.Values, path ...string) *request {
+	escaped := make([]string, 0, len(path))
+	for _, p := range path {
+		escaped = append(escaped, url.PathEscape(p))
+	}
+
+	// nolint:exhaustivestruct
+	return &request{method: method, path: "/v1/" + strings.Join(escaped, "/"), query: query}
+}
+
+// withBody sets the body of the request.
+func (r *request) withBody(body []byte, contentType string) *request {
+	r.body = body
+	r.contentType = contentType
+
+	return r
+}
+
+// idempotent reports whether the request can be safely retried,
+// a merge patch gives the same entity when it is applied again.
+func (r *request) idempotent() bool {
+	switch r.method {
+	case http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// do sends the request and decodes the JSON response into out, a nil out discards the response.
+func (c *Client) do(ctx context.Context, req *request, out interface{}) error {
+	data, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode %s %s response: %w", req.method, req.path, err)
+	}
+
+	return nil
+}
+
+// doJSON sends the request with in encoded as the JSON body and decodes the JSON response into out.
+func (c *Client) doJSON(ctx context.Context, req *request, in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("encode %s %s request: %w", req.method, req.path, err)
+	}
+	contentType := "application/json"
+	if req.method == http.MethodPatch {
+		contentType = mergePatchType
+	}
+
+	return c.do(ctx, req.withBody(b, contentType), out)
+}
+
+// send sends the request and returns the body of a successful response.
+// The idempotent requests are retried on network errors and server errors.
+func (c *Client) send(ctx context.Context, req *request) ([]byte, error) {
+	wait := c.backoff
+	for attempt := 0; ; attempt++ {
+		data, err := c.attempt(ctx, req)
+		if err == nil {
+			return data, nil
+		}
+		if attempt >= c.retries || !req.idempotent() || !retryable(ctx, err) {
+			return nil, err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, fmt.Errorf("%s %s: %w", req.method, req.path, ctx.Err())
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, req *request) ([]byte, error) {
+	u := *c.baseURL
+	u.Path += req.path
+	u.RawQuery = req.query.Encode()
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	hr, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+	hr.Header.Set("Accept", "application/json")
+	if req.contentType != "" {
+		hr.Header.Set("Content-Type", req.contentType)
+	}
+	if c.adminToken != "" {
+		hr.Header.Set(adminTokenHeader, c.adminToken)
+	}
+	resp, err := c.httpClient.Do(hr)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.method, req.path, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read %s %s response: %w", req.method, req.path, err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, decodeError(resp, data)
+	}
+
+	return data, nil
+}
+
+// retryable reports whether a failed attempt may succeed when repeated.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// ListOptions filters and sorts the listings of projects, columns, tasks and comments.
+type ListOptions struct {
+	// Sort is created_at or updated_at, prefixed with - for the descending order.
+	Sort string
+	// ModifiedSince keeps only the items updated since the time.
+	ModifiedSince time.Time
+	// Archived lists the archived projects along with the active ones, it is ignored by the other listings.
+	Archived bool
+}
+
+func (o *ListOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	if !o.ModifiedSince.IsZero() {
+		q.Set("modified_since", o.ModifiedSince.UTC().Format(time.RFC3339))
+	}
+	if o.Archived {
+		q.Set("archived", "true")
+	}
+
+	return q
+}
+
+// rangeValues returns the from and to query params of the reports, the zero times are left out.
+func rangeValues(from, to time.Time) url.Values {
+	q := url.Values{}
+	if !from.IsZero() {
+		q.Set("from", from.UTC().Format(time.RFC3339))
+	}
+	if !to.IsZero() {
+		q.Set("to", to.UTC().Format(time.RFC3339))
+	}
+
+	return q
+}
@@ -0,0 +1,219 @@
+package client_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/app/server"
+	"github.com/igkostyuk/tasktracker/client"
+	"github.com/igkostyuk/tasktracker/configs"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/web"
+	helper "github.com/matryer/is"
+	"go.uber.org/zap"
+)
+
+const adminToken = "secret"
+
+var (
+	projectColumns = []string{
+		"id", "name", "description", "created_at", "updated_at", "archived_at", "deleted_at", "labels",
+	}
+	columnColumns = []string{
+		"id", "position", "name", "status", "category", "wip_limit", "project_id", "created_at", "updated_at", "deleted_at",
+	}
+	getProjectQuery = regexp.QuoteMeta(`FROM projects
+	WHERE id = $1 AND deleted_at IS NULL`)
+)
+
+// api represent the API served on the mocked database.
+type api struct {
+	mock     sqlmock.Sqlmock
+	requests int32
+}
+
+// newClient starts the API on the mocked database and returns a client of it.
+func newClient(t *testing.T, opts ...client.Option) (*client.Client, *api) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	// nolint:exhaustivestruct
+	cfg := configs.Config{AdminToken: adminToken}
+	a := &api{mock: mock}
+	handler := server.New(cfg, zap.NewNop(), db).Handler
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&a.requests, 1)
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		ts.Close()
+		db.Close()
+	})
+	c, err := client.New(ts.URL, append([]client.Option{client.WithRetries(1, time.Millisecond)}, opts...)...)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	return c, a
+}
+
+//nolint:exhaustivestruct,funlen
+func TestProjects(t *testing.T) {
+	id := uuid.New()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	t.Run("returns the project", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(projectColumns).
+				AddRow(id, "Roadmap", "Plans", now, now, nil, nil, []byte(`[{"name":"bug","color":"#d73a4a"}]`)))
+
+		got, err := c.GetProject(context.Background(), id)
+		is.NoErr(err)
+		is.Equal(got, domain.Project{
+			ID: id, Name: "Roadmap", Description: "Plans", CreatedAt: now, UpdatedAt: now,
+			Labels: []domain.Label{{Name: "bug", Color: "#d73a4a"}},
+		})
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("wraps not found", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+		_, err := c.GetProject(context.Background(), id)
+		is.True(errors.Is(err, domain.ErrNotFound))
+		var apiErr *client.Error
+		is.True(errors.As(err, &apiErr))
+		is.Equal(apiErr.StatusCode, http.StatusNotFound)
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("retries a server error", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).WillReturnError(sql.ErrConnDone)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(projectColumns).AddRow(id, "Roadmap", "Plans", now, now, nil, nil, []byte(`[]`)))
+
+		got, err := c.GetProject(context.Background(), id)
+		is.NoErr(err)
+		is.Equal(got.Name, "Roadmap")
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("gives up after the retries", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).WillReturnError(sql.ErrConnDone)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).WillReturnError(sql.ErrConnDone)
+
+		_, err := c.GetProject(context.Background(), id)
+		is.True(errors.Is(err, domain.ErrInternalServerError))
+		is.Equal(atomic.LoadInt32(&a.requests), int32(2))
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("does not retry a create", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery("INSERT INTO projects").WillReturnError(sql.ErrConnDone)
+
+		_, err := c.CreateProject(context.Background(), domain.Project{Name: "Roadmap", Description: "Plans"})
+		is.True(errors.Is(err, domain.ErrInternalServerError))
+		is.Equal(atomic.LoadInt32(&a.requests), int32(1))
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("wraps unique", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(projectColumns).AddRow(id, "Roadmap", "Plans", now, now, nil, nil, []byte(`[]`)))
+		a.mock.ExpectQuery("FROM columns WHERE project_id = \\$1").WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(columnColumns).
+				AddRow(uuid.New(), 0, "Todo", "todo", "todo", nil, id, now, now, nil))
+
+		_, err := c.CreateColumn(context.Background(), domain.Column{Name: "Todo", Status: "open", ProjectID: id})
+		is.True(errors.Is(err, domain.ErrUnique))
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("patches the project", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t)
+		for i := 0; i < 2; i++ {
+			a.mock.ExpectQuery(getProjectQuery).WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(projectColumns).AddRow(id, "Roadmap", "Plans", now, now, nil, nil, []byte(`[]`)))
+		}
+		a.mock.ExpectQuery("UPDATE projects").WithArgs(id, "Roadmap", "Next plans").
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
+		a.mock.ExpectQuery(getProjectQuery).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(projectColumns).AddRow(id, "Roadmap", "Next plans", now, now, nil, nil, []byte(`[]`)))
+
+		got, err := c.PatchProject(context.Background(), id, map[string]string{"description": "Next plans"})
+		is.NoErr(err)
+		is.Equal(got.Description, "Next plans")
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+	t.Run("wraps bad param input of a validation error", func(t *testing.T) {
+		is := helper.New(t)
+		c, _ := newClient(t)
+
+		_, err := c.CreateProject(context.Background(), domain.Project{})
+		is.True(errors.Is(err, domain.ErrBadParamInput))
+		var cerr *client.Error
+		is.True(errors.As(err, &cerr))
+		is.Equal(cerr.Type, client.ProblemTypeValidation)
+		is.Equal(client.ProblemTypeValidation, web.ProblemTypeValidation)
+		is.Equal(cerr.Fields[0].Field, "name")
+		is.Equal(cerr.Fields[0].Rule, "required")
+	})
+}
+
+//nolint:exhaustivestruct
+func TestOverrideWIP(t *testing.T) {
+	tk := domain.Task{Name: "First", Description: "Details", ColumnID: uuid.New()}
+	t.Run("wraps forbidden without the admin token", func(t *testing.T) {
+		is := helper.New(t)
+		c, _ := newClient(t)
+
+		_, err := c.CreateTask(context.Background(), tk, client.OverrideWIP())
+		is.True(errors.Is(err, domain.ErrForbidden))
+	})
+	t.Run("sends the admin token", func(t *testing.T) {
+		is := helper.New(t)
+		c, a := newClient(t, client.WithAdminToken(adminToken))
+		a.mock.ExpectQuery("FROM columns").WillReturnError(sql.ErrNoRows)
+
+		_, err := c.CreateTask(context.Background(), tk, client.OverrideWIP())
+		is.True(errors.Is(err, domain.ErrNotFound))
+		is.NoErr(a.mock.ExpectationsWereMet())
+	})
+}
+
+func TestContext(t *testing.T) {
+	is := helper.New(t)
+	c, a := newClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.ListProjects(ctx, nil)
+	is.True(errors.Is(err, context.Canceled))
+	is.Equal(atomic.LoadInt32(&a.requests), int32(0))
+}
+
+func TestNew(t *testing.T) {
+	is := helper.New(t)
+
+	_, err := client.New("localhost:3000")
+	is.True(err != nil)
+	_, err = client.New("http://localhost:3000/")
+	is.NoErr(err)
+}
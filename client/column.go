@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ListColumns returns the columns of all projects, opts may be nil.
+func (c *Client) ListColumns(ctx context.Context, opts *ListOptions) ([]domain.Column, error) {
+	var res []domain.Column
+	err := c.do(ctx, newRequest(http.MethodGet, opts.values(), "columns"), &res)
+
+	return res, err
+}
+
+// GetColumn returns the column with the id.
+func (c *Client) GetColumn(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	var res domain.Column
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "columns", id.String()), &res)
+
+	return res, err
+}
+
+// ListColumnTasks returns the tasks of the column in position order.
+func (c *Client) ListColumnTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	var res []domain.Task
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "columns", id.String(), "tasks"), &res)
+
+	return res, err
+}
+
+// UpdateColumn updates the column with the id of cl, a changed position moves the column.
+func (c *Client) UpdateColumn(ctx context.Context, cl domain.Column) (domain.Column, error) {
+	var res domain.Column
+	err := c.doJSON(ctx, newRequest(http.MethodPut, nil, "columns", cl.ID.String()), cl, &res)
+
+	return res, err
+}
+
+// PatchColumn applies the JSON merge patch to the column, the patch is encoded as JSON.
+func (c *Client) PatchColumn(ctx context.Context, id uuid.UUID, patch interface{}) (domain.Column, error) {
+	var res domain.Column
+	err := c.doJSON(ctx, newRequest(http.MethodPatch, nil, "columns", id.String()), patch, &res)
+
+	return res, err
+}
+
+// DeleteColumn moves the column to the trash, its tasks are moved to the column on the left.
+func (c *Client) DeleteColumn(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "columns", id.String()), nil)
+}
+
+// RestoreColumn brings the column back from the trash as the last one of its project.
+func (c *Client) RestoreColumn(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	var res domain.Column
+	err := c.do(ctx, newRequest(http.MethodPost, nil, "columns", id.String(), "restore"), &res)
+
+	return res, err
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ListComments returns the comments of all tasks, opts may be nil.
+func (c *Client) ListComments(ctx context.Context, opts *ListOptions) ([]domain.Comment, error) {
+	var res []domain.Comment
+	err := c.do(ctx, newRequest(http.MethodGet, opts.values(), "comments"), &res)
+
+	return res, err
+}
+
+// GetComment returns the comment with the id.
+func (c *Client) GetComment(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
+	var res domain.Comment
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "comments", id.String()), &res)
+
+	return res, err
+}
+
+// UpdateComment updates the comment with the id of cm.
+func (c *Client) UpdateComment(ctx context.Context, cm domain.Comment) (domain.Comment, error) {
+	var res domain.Comment
+	err := c.doJSON(ctx, newRequest(http.MethodPut, nil, "comments", cm.ID.String()), cm, &res)
+
+	return res, err
+}
+
+// PatchComment applies the JSON merge patch to the comment, the patch is encoded as JSON.
+func (c *Client) PatchComment(ctx context.Context, id uuid.UUID, patch interface{}) (domain.Comment, error) {
+	var res domain.Comment
+	err := c.doJSON(ctx, newRequest(http.MethodPatch, nil, "comments", id.String()), patch, &res)
+
+	return res, err
+}
+
+// DeleteComment deletes the comment.
+func (c *Client) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "comments", id.String()), nil)
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ProblemTypeValidation is the problem type of the responses to a request body that failed validation.
+const ProblemTypeValidation = "urn:tasktracker:problem:validation"
+
+// problemErrors are the domain errors of the problem types sent by the API.
+var problemErrors = map[string]error{
+	ProblemTypeValidation:                    domain.ErrBadParamInput,
+	"urn:tasktracker:problem:not-found":      domain.ErrNotFound,
+	"urn:tasktracker:problem:conflict":       domain.ErrConflict,
+	"urn:tasktracker:problem:unique":         domain.ErrUnique,
+	"urn:tasktracker:problem:bad-param":      domain.ErrBadParamInput,
+	"urn:tasktracker:problem:last-column":    domain.ErrLastColumn,
+	"urn:tasktracker:problem:parent-cycle":   domain.ErrParentCycle,
+	"urn:tasktracker:problem:parent-project": domain.ErrParentProject,
+	"urn:tasktracker:problem:link-cycle":     domain.ErrLinkCycle,
+	"urn:tasktracker:problem:blocked":        domain.ErrBlocked,
+	"urn:tasktracker:problem:wip-limit":      domain.ErrWIPLimit,
+	"urn:tasktracker:problem:archived":       domain.ErrArchived,
+	"urn:tasktracker:problem:forbidden":      domain.ErrForbidden,
+	"urn:tasktracker:problem:internal":       domain.ErrInternalServerError,
+}
+
+// problem represent the problem details of an error response.
+type problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail"`
+	RequestID string       `json:"request_id"`
+	Errors    []FieldError `json:"errors"`
+}
+
+// FieldError represent a field of the request body that failed validation.
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. columns[0].name.
+	Field string `json:"field"`
+	// Rule is the failed validation rule, e.g. required or max.
+	Rule    string `json:"rule"`
+	Param   string `json:"param"`
+	Message string `json:"message"`
+}
+
+// Error represent an error response of the API. It wraps the domain error of the response,
+// so it can be checked with errors.Is(err, domain.ErrNotFound).
+type Error struct {
+	StatusCode int
+	Message    string
+	// Type is the problem type URI of the response, e.g. ProblemTypeValidation.
+	Type      string
+	RequestID string
+	// Fields are the fields of the request body that failed validation.
+	Fields []FieldError
+	err    error
+}
+
+func (e *Error) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("tasktracker: %d %s (request %s)", e.StatusCode, e.Message, e.RequestID)
+	}
+
+	return fmt.Sprintf("tasktracker: %d %s", e.StatusCode, e.Message)
+}
+
+// Unwrap returns the domain error of the response.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// decodeError reads the problem details of the response.
+func decodeError(resp *http.Response, data []byte) error {
+	var p problem
+	if err := json.Unmarshal(data, &p); err != nil || p.Status == 0 {
+		// nolint:exhaustivestruct
+		p = problem{Detail: strings.TrimSpace(string(data))}
+	}
+	message := p.Detail
+	if message == "" {
+		message = p.Title
+	}
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+	err := domainError(resp.StatusCode, p.Type)
+
+	return &Error{
+		StatusCode: resp.StatusCode,
+		Message:    message,
+		Type:       p.Type,
+		RequestID:  p.RequestID,
+		Fields:     p.Errors,
+		err:        err,
+	}
+}
+
+// domainError returns the domain error of the problem type or the one implied
+// by the status code, nil when there is none.
+func domainError(status int, problemType string) error {
+	if err, ok := problemErrors[problemType]; ok {
+		return err
+	}
+	switch status {
+	case http.StatusNotFound:
+		return domain.ErrNotFound
+	case http.StatusConflict:
+		return domain.ErrConflict
+	case http.StatusForbidden:
+		return domain.ErrForbidden
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return domain.ErrBadParamInput
+	}
+	if status >= http.StatusInternalServerError {
+		return domain.ErrInternalServerError
+	}
+
+	return nil
+}
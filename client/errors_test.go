@@ -0,0 +1,19 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/igkostyuk/tasktracker/internal/web"
+	helper "github.com/matryer/is"
+)
+
+func TestProblemErrors(t *testing.T) {
+	is := helper.New(t)
+
+	for problemType, err := range problemErrors {
+		if problemType == ProblemTypeValidation {
+			continue
+		}
+		is.Equal(web.ErrorOfType(problemType), err) // problem type of the registry
+	}
+}
@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ListProjects returns the projects, opts may be nil.
+func (c *Client) ListProjects(ctx context.Context, opts *ListOptions) ([]domain.Project, error) {
+	var res []domain.Project
+	err := c.do(ctx, newRequest(http.MethodGet, opts.values(), "projects"), &res)
+
+	return res, err
+}
+
+// ListDeletedProjects returns the projects in the trash.
+func (c *Client) ListDeletedProjects(ctx context.Context) ([]domain.Project, error) {
+	var res []domain.Project
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", "trash"), &res)
+
+	return res, err
+}
+
+// GetProject returns the project with the id.
+func (c *Client) GetProject(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	var res domain.Project
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", id.String()), &res)
+
+	return res, err
+}
+
+// CreateProject stores the project with a default column.
+func (c *Client) CreateProject(ctx context.Context, p domain.Project) (domain.Project, error) {
+	var res domain.Project
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "projects"), p, &res)
+
+	return res, err
+}
+
+// CreateProjectFromTemplate stores the project with the columns of the board template.
+func (c *Client) CreateProjectFromTemplate(
+	ctx context.Context,
+	p domain.Project,
+	templateID uuid.UUID,
+) (domain.Project, error) {
+	var res domain.Project
+	q := url.Values{"template_id": {templateID.String()}}
+	err := c.doJSON(ctx, newRequest(http.MethodPost, q, "projects"), p, &res)
+
+	return res, err
+}
+
+// UpdateProject updates the project with the id of p.
+func (c *Client) UpdateProject(ctx context.Context, p domain.Project) (domain.Project, error) {
+	var res domain.Project
+	err := c.doJSON(ctx, newRequest(http.MethodPut, nil, "projects", p.ID.String()), p, &res)
+
+	return res, err
+}
+
+// PatchProject applies the JSON merge patch to the project, the patch is encoded as JSON,
+// e.g. map[string]interface{}{"description": "Plans"}.
+func (c *Client) PatchProject(ctx context.Context, id uuid.UUID, patch interface{}) (domain.Project, error) {
+	var res domain.Project
+	err := c.doJSON(ctx, newRequest(http.MethodPatch, nil, "projects", id.String()), patch, &res)
+
+	return res, err
+}
+
+// DeleteProject moves the project to the trash.
+func (c *Client) DeleteProject(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "projects", id.String()), nil)
+}
+
+// RestoreProject brings the project back from the trash.
+func (c *Client) RestoreProject(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	return c.projectAction(ctx, id, "restore")
+}
+
+// ArchiveProject makes the board of the project read only.
+func (c *Client) ArchiveProject(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	return c.projectAction(ctx, id, "archive")
+}
+
+// UnarchiveProject makes the board of the project editable again.
+func (c *Client) UnarchiveProject(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	return c.projectAction(ctx, id, "unarchive")
+}
+
+func (c *Client) projectAction(ctx context.Context, id uuid.UUID, action string) (domain.Project, error) {
+	var res domain.Project
+	err := c.do(ctx, newRequest(http.MethodPost, nil, "projects", id.String(), action), &res)
+
+	return res, err
+}
+
+// CloneProject copies the project with its columns and, when asked by the options, its tasks.
+func (c *Client) CloneProject(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error) {
+	var res domain.Project
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "projects", id.String(), "clone"), opts, &res)
+
+	return res, err
+}
+
+// ExportProject returns the export document of the project.
+func (c *Client) ExportProject(ctx context.Context, id uuid.UUID) (domain.Export, error) {
+	var res domain.Export
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "export"), &res)
+
+	return res, err
+}
+
+// ImportProject stores a new project from the export document.
+func (c *Client) ImportProject(ctx context.Context, e domain.Export) (domain.Project, error) {
+	var res domain.Project
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "projects", "import"), e, &res)
+
+	return res, err
+}
+
+// ProjectTrash returns the deleted columns and tasks of the project.
+func (c *Client) ProjectTrash(ctx context.Context, id uuid.UUID) (domain.Trash, error) {
+	var res domain.Trash
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "trash"), &res)
+
+	return res, err
+}
+
+// ListProjectColumns returns the columns of the project in position order.
+func (c *Client) ListProjectColumns(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+	var res []domain.Column
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "columns"), &res)
+
+	return res, err
+}
+
+// CreateColumn stores the column in the project with the ProjectID of cl.
+func (c *Client) CreateColumn(ctx context.Context, cl domain.Column) (domain.Column, error) {
+	var res domain.Column
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "projects", cl.ProjectID.String(), "columns"), cl, &res)
+
+	return res, err
+}
+
+// ListProjectTasks returns the tasks of the project.
+func (c *Client) ListProjectTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	var res []domain.Task
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "tasks"), &res)
+
+	return res, err
+}
+
+// ExportProjectTasks returns the tasks of the project as CSV.
+func (c *Client) ExportProjectTasks(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	return c.send(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "tasks", "export"))
+}
+
+// ImportProjectTasks appends the tasks of the CSV read from r to the named columns of the project.
+func (c *Client) ImportProjectTasks(ctx context.Context, id uuid.UUID, r io.Reader) (domain.TaskImport, error) {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return domain.TaskImport{}, fmt.Errorf("read tasks csv: %w", err)
+	}
+	var res domain.TaskImport
+	req := newRequest(http.MethodPost, nil, "projects", id.String(), "tasks", "import").withBody(body, "text/csv")
+	err = c.do(ctx, req, &res)
+
+	return res, err
+}
+
+// FlowMetrics returns the flow metrics of the project between from and to,
+// the zero times leave the default range of the API.
+func (c *Client) FlowMetrics(ctx context.Context, id uuid.UUID, from, to time.Time) (domain.FlowMetrics, error) {
+	var res domain.FlowMetrics
+	err := c.do(ctx, newRequest(http.MethodGet, rangeValues(from, to), "projects", id.String(), "metrics", "flow"), &res)
+
+	return res, err
+}
+
+// CFD returns the cumulative flow diagram of the project between from and to,
+// the zero times leave the default range of the API.
+func (c *Client) CFD(ctx context.Context, id uuid.UUID, from, to time.Time) (domain.CFD, error) {
+	var res domain.CFD
+	err := c.do(ctx, newRequest(http.MethodGet, rangeValues(from, to), "projects", id.String(), "reports", "cfd"), &res)
+
+	return res, err
+}
+
+// ProjectReport returns the board report of the project rendered in the format, markdown or html.
+func (c *Client) ProjectReport(ctx context.Context, id uuid.UUID, format string) ([]byte, error) {
+	q := url.Values{}
+	if format != "" {
+		q.Set("format", format)
+	}
+
+	return c.send(ctx, newRequest(http.MethodGet, q, "projects", id.String(), "report"))
+}
+
+// ProjectFeed returns the Atom feed of the project activity.
+func (c *Client) ProjectFeed(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	return c.send(ctx, newRequest(http.MethodGet, nil, "projects", id.String(), "feed.atom"))
+}
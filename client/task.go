@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// TaskOption configures a request placing a task in a column.
+type TaskOption func(url.Values)
+
+// OverrideWIP places the task over the work in progress limit of the column,
+// the client must be authenticated with the admin token.
+func OverrideWIP() TaskOption {
+	return func(q url.Values) {
+		q.Set("override_wip", "true")
+	}
+}
+
+func taskValues(opts []TaskOption) url.Values {
+	q := url.Values{}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	return q
+}
+
+// ListTasks returns the tasks of all projects, opts may be nil.
+func (c *Client) ListTasks(ctx context.Context, opts *ListOptions) ([]domain.Task, error) {
+	var res []domain.Task
+	err := c.do(ctx, newRequest(http.MethodGet, opts.values(), "tasks"), &res)
+
+	return res, err
+}
+
+// GetTask returns the task with the id.
+func (c *Client) GetTask(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+	var res domain.Task
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "tasks", id.String()), &res)
+
+	return res, err
+}
+
+// CreateTask stores the task in the column with the ColumnID of tk.
+func (c *Client) CreateTask(ctx context.Context, tk domain.Task, opts ...TaskOption) (domain.Task, error) {
+	var res domain.Task
+	err := c.doJSON(ctx, newRequest(http.MethodPost, taskValues(opts), "tasks"), tk, &res)
+
+	return res, err
+}
+
+// UpdateTask updates the task with the id of tk, a changed column or position moves the task.
+func (c *Client) UpdateTask(ctx context.Context, tk domain.Task, opts ...TaskOption) (domain.Task, error) {
+	var res domain.Task
+	err := c.doJSON(ctx, newRequest(http.MethodPut, taskValues(opts), "tasks", tk.ID.String()), tk, &res)
+
+	return res, err
+}
+
+// PatchTask applies the JSON merge patch to the task, the patch is encoded as JSON.
+// Only the members of the patch are changed, so it does not overwrite the fields changed by others.
+func (c *Client) PatchTask(
+	ctx context.Context,
+	id uuid.UUID,
+	patch interface{},
+	opts ...TaskOption,
+) (domain.Task, error) {
+	var res domain.Task
+	err := c.doJSON(ctx, newRequest(http.MethodPatch, taskValues(opts), "tasks", id.String()), patch, &res)
+
+	return res, err
+}
+
+// DeleteTask moves the task with its comments to the trash.
+func (c *Client) DeleteTask(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "tasks", id.String()), nil)
+}
+
+// RestoreTask brings the task back from the trash.
+func (c *Client) RestoreTask(ctx context.Context, id uuid.UUID, opts ...TaskOption) (domain.Task, error) {
+	var res domain.Task
+	err := c.do(ctx, newRequest(http.MethodPost, taskValues(opts), "tasks", id.String(), "restore"), &res)
+
+	return res, err
+}
+
+// ListTaskComments returns the comments of the task.
+func (c *Client) ListTaskComments(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+	var res []domain.Comment
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "tasks", id.String(), "comments"), &res)
+
+	return res, err
+}
+
+// CreateComment stores the comment on the task with the TaskID of cm.
+func (c *Client) CreateComment(ctx context.Context, cm domain.Comment) (domain.Comment, error) {
+	var res domain.Comment
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "tasks", cm.TaskID.String(), "comments"), cm, &res)
+
+	return res, err
+}
+
+// TaskChildren returns the subtasks of the task with the progress of their columns.
+func (c *Client) TaskChildren(ctx context.Context, id uuid.UUID) (domain.TaskChildren, error) {
+	var res domain.TaskChildren
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "tasks", id.String(), "children"), &res)
+
+	return res, err
+}
+
+// ListTaskLinks returns the links of the task.
+func (c *Client) ListTaskLinks(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+	var res []domain.Link
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "tasks", id.String(), "links"), &res)
+
+	return res, err
+}
+
+// CreateLink stores the link from the task with the TaskID of l.
+func (c *Client) CreateLink(ctx context.Context, l domain.Link) (domain.Link, error) {
+	var res domain.Link
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "tasks", l.TaskID.String(), "links"), l, &res)
+
+	return res, err
+}
+
+// DeleteLink deletes the link of the task.
+func (c *Client) DeleteLink(ctx context.Context, taskID, linkID uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "tasks", taskID.String(), "links", linkID.String()), nil)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ListTemplates returns the board templates.
+func (c *Client) ListTemplates(ctx context.Context) ([]domain.Template, error) {
+	var res []domain.Template
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "templates"), &res)
+
+	return res, err
+}
+
+// GetTemplate returns the board template with the id.
+func (c *Client) GetTemplate(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	var res domain.Template
+	err := c.do(ctx, newRequest(http.MethodGet, nil, "templates", id.String()), &res)
+
+	return res, err
+}
+
+// CreateTemplate stores the board template.
+func (c *Client) CreateTemplate(ctx context.Context, tp domain.Template) (domain.Template, error) {
+	var res domain.Template
+	err := c.doJSON(ctx, newRequest(http.MethodPost, nil, "templates"), tp, &res)
+
+	return res, err
+}
+
+// UpdateTemplate updates the board template with the id of tp.
+func (c *Client) UpdateTemplate(ctx context.Context, tp domain.Template) (domain.Template, error) {
+	var res domain.Template
+	err := c.doJSON(ctx, newRequest(http.MethodPut, nil, "templates", tp.ID.String()), tp, &res)
+
+	return res, err
+}
+
+// DeleteTemplate deletes the board template.
+func (c *Client) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, newRequest(http.MethodDelete, nil, "templates", id.String()), nil)
+}
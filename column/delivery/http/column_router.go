@@ -2,14 +2,13 @@ package router
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi"
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/middleware"
 	"github.com/igkostyuk/tasktracker/internal/web"
 )
 
@@ -27,7 +26,9 @@ func New(us domain.ColumnUsecase) chi.Router {
 	r.Route("/{columnID}", func(r chi.Router) {
 		r.Get("/", handler.GetByID)
 		r.Put("/", handler.Update)
+		r.Patch("/", handler.Patch)
 		r.Delete("/", handler.Delete)
+		r.Post("/restore", handler.Restore)
 		r.Get("/tasks", handler.FetchTasks)
 	})
 
@@ -39,16 +40,25 @@ func New(us domain.ColumnUsecase) chi.Router {
 // @Description get all columns
 // @Tags columns
 // @Produce  json
+// @Param sort query string false "sort key created_at or updated_at, prefixed with - for descending order"
+// @Param modified_since query string false "only items updated since the time" format(date-time)
 // @Success 200 {array} domain.Column
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /columns [get]
 // Fetch will fetch columns.
 func (c *columnHandler) Fetch(w http.ResponseWriter, r *http.Request) {
-	columns, err := c.columnUsecase.Fetch(r.Context())
+	f, err := web.ParseFilter(r)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	columns, err := c.columnUsecase.Fetch(r.Context(), f)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -62,21 +72,21 @@ func (c *columnHandler) Fetch(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "column ID" format(uuid)
 // @Success 200 {array} domain.Task
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /columns/{id}/tasks [get]
 // FetchTasks will fetch tasks by column id.
 func (c *columnHandler) FetchTasks(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	tasks, err := c.columnUsecase.FetchTasks(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -90,21 +100,21 @@ func (c *columnHandler) FetchTasks(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "column ID" format(uuid)
 // @Success 200 {object} domain.Column
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /columns/{id} [get]
 // GetByID will get column by given id.
 func (c *columnHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	column, err := c.columnUsecase.GetByID(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -112,8 +122,7 @@ func (c *columnHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func isRequestValid(m *domain.Column) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -130,34 +139,102 @@ func isRequestValid(m *domain.Column) (bool, error) {
 // @Param  id path string true "column ID"
 // @Param column body domain.Column true "Update column"
 // @Success 200 {object} domain.Column
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /columns/{id} [put]
 // Update will store the column by given request body.
 func (c *columnHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var column domain.Column
 	if err := json.NewDecoder(r.Body).Decode(&column); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	column.ID = id
 	if ok, err := isRequestValid(&column); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
 	if err := c.columnUsecase.Update(r.Context(), &column); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	// Read the column back to respond with the timestamps set by the database.
+	column, err = c.columnUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, column, http.StatusOK)
+}
+
+// Patch godoc
+// @Summary Patch a column
+// @Description update the column by a JSON merge patch applied on top of the stored column
+// @Tags columns
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param  id path string true "column ID" format(uuid)
+// @Param patch body domain.Column true "JSON merge patch of the column"
+// @Success 200 {object} domain.Column
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 415 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /columns/{id} [patch]
+// Patch will update the column by the JSON merge patch of the request body.
+func (c *columnHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if !web.IsMergePatch(r) {
+		web.RespondRequestError(w, r, web.ErrMergePatchType, http.StatusUnsupportedMediaType)
+
+		return
+	}
+	column, err := c.columnUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := web.MergePatch(&column, r.Body); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	column.ID = id
+	if ok, err := isRequestValid(&column); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := c.columnUsecase.Update(r.Context(), &column); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	// Read the column back to respond with the timestamps set by the database.
+	column, err = c.columnUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -166,46 +243,64 @@ func (c *columnHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 // Delete godoc
 // @Summary Delete a column
-// @Description Delete by column ID
+// @Description Move the column to the trash, its tasks move to the neighbour column
 // @Tags columns
 // @Produce  json
 // @Param  id path string true "column ID"
+// @Param override_wip query bool false "move the tasks over the WIP limit of the neighbour column, admin only"
 // @Success 204 "it's ok"
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 403 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /columns/{id} [delete]
 // Delete will delete column by given param.
 func (c *columnHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
-	if err := c.columnUsecase.Delete(r.Context(), id); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+	ctx, err := middleware.WIPOverride(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := c.columnUsecase.Delete(ctx, id); err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func getStatusCode(err error) int {
-	if err == nil {
-		return http.StatusOK
+// Restore godoc
+// @Summary Restore a column
+// @Description restore a deleted column as the last one of its project
+// @Tags columns
+// @Produce  json
+// @Param  id path string true "column ID" format(uuid)
+// @Success 200 {object} domain.Column
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /columns/{id}/restore [post]
+// Restore will restore the deleted column by given param.
+func (c *columnHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "columnID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
 	}
+	column, err := c.columnUsecase.Restore(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
-	switch {
-	case errors.Is(err, domain.ErrNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrConflict):
-		return http.StatusConflict
-	case errors.Is(err, domain.ErrLastColumn):
-		return http.StatusConflict
-	case errors.Is(err, domain.ErrUnique):
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
+		return
 	}
+	web.Respond(w, r, column, http.StatusOK)
 }
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	store "github.com/igkostyuk/tasktracker/store/postgres"
 )
 
 type columnRepository struct {
@@ -33,7 +35,12 @@ func (c *columnRepository) fetch(ctx context.Context, query string, args ...inte
 			&t.Position,
 			&t.Name,
 			&t.Status,
+			&t.Category,
+			&t.WIPLimit,
 			&t.ProjectID,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("rows scan error: %w", err)
@@ -48,14 +55,31 @@ func (c *columnRepository) fetch(ctx context.Context, query string, args ...inte
 	return result, nil
 }
 
-func (c *columnRepository) Fetch(ctx context.Context) ([]domain.Column, error) {
-	query := `SELECT id,position,name,status,project_id FROM columns ORDER BY position`
+func (c *columnRepository) Fetch(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
+	// nolint:gosec // order by is built from the whitelisted sort keys.
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE deleted_at IS NULL AND updated_at >= $1 ORDER BY ` + store.OrderBy(f, "position")
 
-	return c.fetch(ctx, query)
+	return c.fetch(ctx, query, f.ModifiedSince)
 }
 
 func (c *columnRepository) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
-	query := `SELECT id,position,name,status,project_id FROM columns WHERE project_id = $1 ORDER BY position`
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE project_id = $1 AND deleted_at IS NULL ORDER BY position`
+
+	return c.fetch(ctx, query, id)
+}
+
+func (c *columnRepository) FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE project_id = ANY($1::uuid[]) AND deleted_at IS NULL ORDER BY project_id, position`
+
+	return c.fetch(ctx, query, store.IDs(ids))
+}
+
+func (c *columnRepository) FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE project_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
 
 	return c.fetch(ctx, query, id)
 }
@@ -68,7 +92,12 @@ func (c *columnRepository) getOne(ctx context.Context, query string, args ...int
 		&res.Position,
 		&res.Name,
 		&res.Status,
+		&res.Category,
+		&res.WIPLimit,
 		&res.ProjectID,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+		&res.DeletedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Column{}, fmt.Errorf("column: %w", domain.ErrNotFound)
@@ -81,7 +110,15 @@ func (c *columnRepository) getOne(ctx context.Context, query string, args ...int
 }
 
 func (c *columnRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Column, error) {
-	query := `SELECT id,position,name,status,project_id FROM columns WHERE id = $1`
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE id = $1 AND deleted_at IS NULL`
+
+	return c.getOne(ctx, query, id)
+}
+
+func (c *columnRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	query := `SELECT id,position,name,status,category,wip_limit,project_id,created_at,updated_at,deleted_at
+	FROM columns WHERE id = $1 AND deleted_at IS NOT NULL`
 
 	return c.getOne(ctx, query, id)
 }
@@ -91,7 +128,7 @@ func (c *columnRepository) Update(ctx context.Context, cls ...domain.Column) err
 	if err != nil {
 		return fmt.Errorf("tx begin: %w", err)
 	}
-	query := `UPDATE columns SET position=$2,name=$3,status=$4,project_id=$5 WHERE id = $1`
+	query := `UPDATE columns SET position=$2,name=$3,status=$4,category=$5,wip_limit=$6,project_id=$7 WHERE id = $1`
 	stmt, err := txn.Prepare(query)
 	if err != nil {
 		return fmt.Errorf("tx prepare: %w", err)
@@ -99,7 +136,7 @@ func (c *columnRepository) Update(ctx context.Context, cls ...domain.Column) err
 	defer stmt.Close()
 
 	for _, c := range cls {
-		_, err = stmt.Exec(c.ID, c.Position, c.Name, c.Status, c.ProjectID)
+		_, err = stmt.Exec(c.ID, c.Position, c.Name, c.Status, c.Category, c.WIPLimit, c.ProjectID)
 		if err != nil {
 			return fmt.Errorf("smt exec: %w", err)
 		}
@@ -115,9 +152,10 @@ func (c *columnRepository) Update(ctx context.Context, cls ...domain.Column) err
 }
 
 func (c *columnRepository) Store(ctx context.Context, a *domain.Column) error {
-	query := `INSERT INTO columns (position,name,status,project_id) VALUES ( $1, $2, $3, $4) RETURNING id`
-	row := c.db.QueryRowContext(ctx, query, a.Position, a.Name, a.Status, a.ProjectID)
-	err := row.Scan(&a.ID)
+	query := `INSERT INTO columns (position,name,status,category,wip_limit,project_id)
+	VALUES ( $1, $2, $3, $4, $5, $6) RETURNING id,created_at,updated_at`
+	row := c.db.QueryRowContext(ctx, query, a.Position, a.Name, a.Status, a.Category, a.WIPLimit, a.ProjectID)
+	err := row.Scan(&a.ID, &a.CreatedAt, &a.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("store error: %w", err)
 	}
@@ -126,7 +164,7 @@ func (c *columnRepository) Store(ctx context.Context, a *domain.Column) error {
 }
 
 func (c *columnRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM columns WHERE id = $1`
+	query := `UPDATE columns SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 	_, err := c.db.ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("delete error: %w", err)
@@ -134,3 +172,32 @@ func (c *columnRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (c *columnRepository) Restore(ctx context.Context, cl *domain.Column) error {
+	query := `UPDATE columns SET deleted_at = NULL, position = $2 WHERE id = $1 AND deleted_at IS NOT NULL
+	RETURNING updated_at`
+	err := c.db.QueryRowContext(ctx, query, cl.ID, cl.Position).Scan(&cl.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("column: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("restore error: %w", err)
+	}
+	cl.DeletedAt = nil
+
+	return nil
+}
+
+func (c *columnRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM columns WHERE deleted_at < $1`
+	res, err := c.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge error: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return n, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -10,17 +11,18 @@ import (
 )
 
 type columnUsecase struct {
-	columnRepo domain.ColumnRepository
-	taskRepo   domain.TaskRepository
+	columnRepo  domain.ColumnRepository
+	taskRepo    domain.TaskRepository
+	projectRepo domain.ProjectRepository
 }
 
 // New will create new a ColumnUsecase object representation of domain.ColumnUsecase interface.
-func New(c domain.ColumnRepository, t domain.TaskRepository) domain.ColumnUsecase {
-	return &columnUsecase{columnRepo: c, taskRepo: t}
+func New(c domain.ColumnRepository, t domain.TaskRepository, p domain.ProjectRepository) domain.ColumnUsecase {
+	return &columnUsecase{columnRepo: c, taskRepo: t, projectRepo: p}
 }
 
-func (c *columnUsecase) Fetch(ctx context.Context) ([]domain.Column, error) {
-	return c.columnRepo.Fetch(ctx)
+func (c *columnUsecase) Fetch(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
+	return c.columnRepo.Fetch(ctx, f)
 }
 
 func (c *columnUsecase) FetchTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
@@ -35,6 +37,10 @@ func (c *columnUsecase) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]d
 	return c.columnRepo.FetchByProjectID(ctx, id)
 }
 
+func (c *columnUsecase) FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+	return c.columnRepo.FetchByProjectIDs(ctx, ids)
+}
+
 func (c *columnUsecase) GetByID(ctx context.Context, id uuid.UUID) (domain.Column, error) {
 	return c.columnRepo.GetByID(ctx, id)
 }
@@ -45,9 +51,17 @@ func (c *columnUsecase) Update(ctx context.Context, cl *domain.Column) error {
 		return fmt.Errorf("fetch by id: %w", err)
 	}
 	cl.ProjectID = old.ProjectID
+	cl.CreatedAt = old.CreatedAt
+	cl.UpdatedAt = old.UpdatedAt
+	if cl.Category == "" {
+		cl.Category = old.Category
+	}
 	if reflect.DeepEqual(old, *cl) {
 		return nil
 	}
+	if err = domain.CheckArchived(c.projectRepo.GetByID(ctx, cl.ProjectID)); err != nil {
+		return err
+	}
 	columns, err := c.columnRepo.FetchByProjectID(ctx, cl.ProjectID)
 	if err != nil {
 		return fmt.Errorf("fetch by project id: %w", err)
@@ -96,6 +110,9 @@ func (c *columnUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 	if err != nil {
 		return fmt.Errorf("get column by id: %w", err)
 	}
+	if err = domain.CheckArchived(c.projectRepo.GetByID(ctx, column.ProjectID)); err != nil {
+		return err
+	}
 	columns, err := c.columnRepo.FetchByProjectID(ctx, column.ProjectID)
 	if err != nil {
 		return fmt.Errorf("fetch columns by project id: %w", err)
@@ -108,14 +125,44 @@ func (c *columnUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 	if leftColumnIndex < 0 {
 		leftColumnIndex = 1
 	}
-	if err := c.moveTasks(ctx, column.ID, columns[leftColumnIndex].ID); err != nil {
+	if err := c.moveTasks(ctx, column.ID, &columns[leftColumnIndex]); err != nil {
 		return err
 	}
 
 	return c.columnRepo.Delete(ctx, id)
 }
 
-func (c *columnUsecase) moveTasks(ctx context.Context, columnID, leftColumnID uuid.UUID) error {
+// Restore brings the column back as the last one of its project.
+func (c *columnUsecase) Restore(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	column, err := c.columnRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("get deleted column by id: %w", err)
+	}
+	project, err := c.projectRepo.GetByID(ctx, column.ProjectID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.Column{}, fmt.Errorf("project of the column is deleted: %w", domain.ErrConflict)
+	}
+	if err = domain.CheckArchived(project, err); err != nil {
+		return domain.Column{}, err
+	}
+	columns, err := c.columnRepo.FetchByProjectID(ctx, column.ProjectID)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	if ok, err := isUnique(columns, &column); !ok {
+		return domain.Column{}, err
+	}
+	column.Position = len(columns)
+	if err = c.columnRepo.Restore(ctx, &column); err != nil {
+		return domain.Column{}, fmt.Errorf("restore column: %w", err)
+	}
+
+	return column, nil
+}
+
+// moveTasks appends the tasks of the column to the left column and records their transitions,
+// the tasks placed over the WIP limit of the left column are refused unless it is overridden.
+func (c *columnUsecase) moveTasks(ctx context.Context, columnID uuid.UUID, left *domain.Column) error {
 	tasks, err := c.taskRepo.FetchByColumnID(ctx, columnID)
 	if err != nil {
 		return fmt.Errorf("fetch tasks by column id: %w", err)
@@ -123,16 +170,27 @@ func (c *columnUsecase) moveTasks(ctx context.Context, columnID, leftColumnID uu
 	if len(tasks) == 0 {
 		return nil
 	}
-	leftTasks, err := c.taskRepo.FetchByColumnID(ctx, leftColumnID)
+	leftTasks, err := c.taskRepo.FetchByColumnID(ctx, left.ID)
 	if err != nil {
 		return fmt.Errorf("fetch tasks by left column id: %w", err)
 	}
+	recs := make([]domain.TaskRecords, 0, len(tasks))
 	for i := range tasks {
-		tasks[i].ColumnID = leftColumnID
+		count := len(leftTasks) + i
+		overridden, wipErr := domain.CheckWIPLimit(ctx, left, count)
+		if wipErr != nil {
+			return wipErr
+		}
+		tasks[i].ColumnID = left.ID
 		tasks[i].Position += len(leftTasks)
+		tasks[i].SetCompletedAt(left)
+		recs = append(recs, domain.NewTaskRecords(tasks[i].ID, &columnID, left, count+1, overridden))
+	}
+	if err = c.taskRepo.Move(ctx, recs, tasks...); err != nil {
+		return fmt.Errorf("move tasks: %w", err)
 	}
 
-	return c.taskRepo.Update(ctx, tasks...)
+	return nil
 }
 
 func isUnique(columns []domain.Column, column *domain.Column) (bool, error) {
@@ -2,9 +2,11 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	columnUsecase "github.com/igkostyuk/tasktracker/column/usecase"
@@ -19,12 +21,12 @@ func TestFetch(t *testing.T) {
 	want := []domain.Column{{Name: "test", Status: "testStatus"}}
 	// nolint:exhaustivestruct
 	mockedColumnRepo := &mocks.ColumnRepositoryMock{
-		FetchFunc: func(ctx context.Context) ([]domain.Column, error) {
+		FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
 			return want, nil
 		},
 	}
-	u := columnUsecase.New(mockedColumnRepo, &mocks.TaskRepositoryMock{})
-	projects, err := u.Fetch(context.TODO())
+	u := columnUsecase.New(mockedColumnRepo, &mocks.TaskRepositoryMock{}, projectRepo())
+	projects, err := u.Fetch(context.TODO(), domain.Filter{})
 	is.NoErr(err)
 	is.Equal(want, projects)
 	is.Equal(len(mockedColumnRepo.FetchCalls()), 1)
@@ -47,7 +49,7 @@ func TestFetchTask(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := columnUsecase.New(mc, mt)
+	u := columnUsecase.New(mc, mt, projectRepo())
 	columns, err := u.FetchTasks(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, columns)
@@ -75,7 +77,7 @@ func TestFetchTaskError(t *testing.T) {
 			return nil, nil
 		},
 	}
-	u := columnUsecase.New(mc, mt)
+	u := columnUsecase.New(mc, mt, projectRepo())
 	_, err := u.FetchTasks(context.TODO(), id)
 	is.True(err != nil)
 
@@ -98,7 +100,7 @@ func TestGetByID(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+	u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 	project, err := u.GetByID(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, project)
@@ -117,7 +119,7 @@ func TestFetchByProjectID(t *testing.T) {
 			return []domain.Column{}, nil
 		},
 	}
-	u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+	u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 	_, err := u.FetchByProjectID(context.TODO(), id)
 	is.NoErr(err)
 	cf := mc.FetchByProjectIDCalls()
@@ -177,7 +179,7 @@ func TestMoveRight(t *testing.T) {
 			}
 			// nolint:exhaustivestruct
 			cl := domain.Column{Name: "test", Position: tc.to}
-			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 			err := u.MoveRight(context.TODO(), &columns[tc.from], &cl, columns)
 			is.NoErr(err)
 			cu := mc.UpdateCalls()
@@ -238,7 +240,7 @@ func TestMoveLeft(t *testing.T) {
 				},
 			}
 			cl := domain.Column{Name: "test", Position: tc.to}
-			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 			err := u.MoveLeft(context.TODO(), &columns[tc.from], &cl, columns)
 			is.NoErr(err)
 			cu := mc.UpdateCalls()
@@ -277,7 +279,7 @@ func TestUpdate(t *testing.T) {
 					return nil
 				},
 			}
-			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 			err := u.Update(context.TODO(), &tc.cl)
 			is.NoErr(err)
 			cg := mc.GetByIDCalls()
@@ -341,7 +343,7 @@ func TestUpdateError(t *testing.T) {
 			}
 			// nolint:exhaustivestruct
 			column := domain.Column{Name: tc.columnName, ID: uuid.New()}
-			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{})
+			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, projectRepo())
 			err := u.Update(context.TODO(), &column)
 			is.True(err != nil)
 		})
@@ -390,18 +392,18 @@ func TestDelete(t *testing.T) {
 
 					return []domain.Task{{ColumnID: secondColumnID}}, nil
 				},
-				UpdateFunc: func(ctx context.Context, tks ...domain.Task) error {
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
 					return nil
 				},
 			}
-			u := columnUsecase.New(mc, mt)
+			u := columnUsecase.New(mc, mt, projectRepo())
 			err := u.Delete(context.TODO(), tc.columnID)
 			is.NoErr(err)
 			ccg := mc.GetByIDCalls()
 			ccf := mc.FetchByProjectIDCalls()
 			ccd := mc.DeleteCalls()
 			ctf := mt.FetchByColumnIDCalls()
-			ctu := mt.UpdateCalls()
+			ctu := mt.MoveCalls()
 			is.Equal(len(ccg), 1)
 			is.Equal(ccg[0].ID, tc.columnID)
 			is.Equal(len(ccf), 1)
@@ -423,6 +425,174 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+//nolint:exhaustivestruct
+func TestDeleteTransitions(t *testing.T) {
+	is := helper.New(t)
+	projectID := uuid.New()
+	leftID := uuid.New()
+	deletedID := uuid.New()
+	leftTasks := []domain.Task{{ID: uuid.New(), ColumnID: leftID, Position: 0}}
+	tasks := []domain.Task{
+		{ID: uuid.New(), ColumnID: deletedID, Position: 0},
+		{ID: uuid.New(), ColumnID: deletedID, Position: 1},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+			return domain.Column{ID: deletedID, ProjectID: projectID, Position: 1}, nil
+		},
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: leftID, Position: 0}, {ID: deletedID, Position: 1}}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			return nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			if id == leftID {
+				return leftTasks, nil
+			}
+
+			return tasks, nil
+		},
+		MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+			return nil
+		},
+	}
+	u := columnUsecase.New(mc, mt, projectRepo())
+	is.NoErr(u.Delete(context.TODO(), deletedID))
+
+	cm := mt.MoveCalls()
+	is.Equal(len(cm), 1)
+	is.Equal(len(cm[0].Tks), 2)
+	is.Equal(len(cm[0].Recs), 2)
+	for i, rec := range cm[0].Recs {
+		is.Equal(cm[0].Tks[i].ColumnID, leftID)
+		is.Equal(cm[0].Tks[i].Position, i+1)
+		is.Equal(rec.Transition.TaskID, tasks[i].ID)
+		is.Equal(*rec.Transition.FromColumnID, deletedID)
+		is.Equal(rec.Transition.ToColumnID, leftID)
+		is.True(rec.WIPOverride == nil)
+	}
+}
+
+//nolint:exhaustivestruct
+func TestDeleteCompletedAt(t *testing.T) {
+	completedAt := time.Now().UTC()
+	tt := []struct {
+		name          string
+		leftCategory  domain.Category
+		completedAt   *time.Time
+		wantCompleted bool
+	}{
+		{"leave done column", domain.CategoryTodo, &completedAt, false},
+		{"enter done column", domain.CategoryDone, nil, true},
+		{"stay done", domain.CategoryDone, &completedAt, true},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			leftID := uuid.New()
+			deletedID := uuid.New()
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					return domain.Column{ID: deletedID, Position: 1}, nil
+				},
+				FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+					return []domain.Column{{ID: leftID, Category: tc.leftCategory}, {ID: deletedID, Position: 1}}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					return nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					if id == leftID {
+						return nil, nil
+					}
+
+					return []domain.Task{{ID: uuid.New(), ColumnID: deletedID, CompletedAt: tc.completedAt}}, nil
+				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			u := columnUsecase.New(mc, mt, projectRepo())
+			is.NoErr(u.Delete(context.TODO(), deletedID))
+
+			moved := mt.MoveCalls()[0].Tks[0]
+			is.Equal(moved.CompletedAt != nil, tc.wantCompleted)
+			if tc.completedAt != nil && tc.wantCompleted {
+				is.Equal(*moved.CompletedAt, completedAt)
+			}
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestDeleteWIPLimit(t *testing.T) {
+	limit := 2
+	tt := []struct {
+		name     string
+		override bool
+		want     error
+	}{
+		{"over the limit", false, domain.ErrWIPLimit},
+		{"overridden", true, nil},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			leftID := uuid.New()
+			deletedID := uuid.New()
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					return domain.Column{ID: deletedID, Position: 1}, nil
+				},
+				FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+					return []domain.Column{{ID: leftID, WIPLimit: &limit}, {ID: deletedID, Position: 1}}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					return nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					if id == leftID {
+						return []domain.Task{{ID: uuid.New(), ColumnID: leftID}}, nil
+					}
+
+					return []domain.Task{{ID: uuid.New(), ColumnID: deletedID}, {ID: uuid.New(), ColumnID: deletedID}}, nil
+				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			ctx := context.TODO()
+			if tc.override {
+				ctx = domain.WithWIPOverride(ctx)
+			}
+			u := columnUsecase.New(mc, mt, projectRepo())
+			err := u.Delete(ctx, deletedID)
+			if tc.want != nil {
+				is.True(errors.Is(err, tc.want))
+				is.Equal(len(mt.MoveCalls()), 0)
+				is.Equal(len(mc.DeleteCalls()), 0)
+
+				return
+			}
+			is.NoErr(err)
+			recs := mt.MoveCalls()[0].Recs
+			is.True(recs[0].WIPOverride == nil)
+			is.Equal(recs[1].WIPOverride.ColumnID, leftID)
+			is.Equal(recs[1].WIPOverride.WIPLimit, limit)
+			is.Equal(recs[1].WIPOverride.Count, 3)
+		})
+	}
+}
+
 // nolint:funlen
 func TestDeleteError(t *testing.T) {
 	is := helper.New(t)
@@ -442,7 +612,7 @@ func TestDeleteError(t *testing.T) {
 		{"column get by id error", secondColumnID, fmt.Errorf("some error"), nil, nil, nil},
 		{"column fetch by project id error", secondColumnID, nil, fmt.Errorf("some error"), nil, nil},
 		{"task fetch by column id error", secondColumnID, nil, nil, fmt.Errorf("some error"), nil},
-		{"task update error", secondColumnID, nil, nil, nil, fmt.Errorf("some error")},
+		{"task move error", secondColumnID, nil, nil, nil, fmt.Errorf("some error")},
 		{"task update error", thirdColumnID, nil, nil, fmt.Errorf("some error"), nil},
 		{"last column", firstColumnID, nil, nil, nil, fmt.Errorf("some error")},
 	}
@@ -481,13 +651,85 @@ func TestDeleteError(t *testing.T) {
 
 					return []domain.Task{{ColumnID: secondColumnID}}, nil
 				},
-				UpdateFunc: func(ctx context.Context, tks ...domain.Task) error {
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
 					return tc.taskUpdateError
 				},
 			}
-			u := columnUsecase.New(mc, mt)
+			u := columnUsecase.New(mc, mt, projectRepo())
 			err := u.Delete(context.TODO(), tc.columnID)
 			is.True(err != nil)
 		})
 	}
 }
+
+//nolint:exhaustivestruct
+func TestRestore(t *testing.T) {
+	projectID := uuid.New()
+	deletedID := uuid.New()
+	tt := []struct {
+		name       string
+		deleted    domain.Column
+		projectErr error
+		want       error
+	}{
+		{"success", domain.Column{ID: deletedID, Name: "new", Status: "new", ProjectID: projectID}, nil, nil},
+		{
+			"not unique",
+			domain.Column{ID: deletedID, Name: "todo", Status: "new", ProjectID: projectID},
+			nil,
+			domain.ErrUnique,
+		},
+		{
+			"deleted project",
+			domain.Column{ID: deletedID, Name: "new", Status: "new", ProjectID: projectID},
+			fmt.Errorf("project: %w", domain.ErrNotFound),
+			domain.ErrConflict,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					return tc.deleted, nil
+				},
+				FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+					return []domain.Column{
+						{ID: uuid.New(), Name: "todo", Status: "todo", Position: 0},
+						{ID: uuid.New(), Name: "done", Status: "done", Position: 1},
+					}, nil
+				},
+				RestoreFunc: func(ctx context.Context, cl *domain.Column) error {
+					return nil
+				},
+			}
+			mp := &mocks.ProjectRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+					return domain.Project{ID: id}, tc.projectErr
+				},
+			}
+			u := columnUsecase.New(mc, &mocks.TaskRepositoryMock{}, mp)
+			got, err := u.Restore(context.TODO(), deletedID)
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mc.RestoreCalls()), 0)
+
+				return
+			}
+			is.Equal(got.Position, 2)
+			is.Equal(len(mc.RestoreCalls()), 1)
+		})
+	}
+}
+
+// nolint:exhaustivestruct
+func projectRepo() *mocks.ProjectRepositoryMock {
+	return &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+		GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: uuid.New()}, nil
+		},
+	}
+}
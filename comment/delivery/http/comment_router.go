@@ -2,12 +2,10 @@ package router
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi"
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
 	"github.com/igkostyuk/tasktracker/internal/web"
@@ -28,6 +26,7 @@ func New(us domain.CommentUsecase) chi.Router {
 		r.Get("/", handler.GetByID)
 		r.Delete("/", handler.Delete)
 		r.Put("/", handler.Update)
+		r.Patch("/", handler.Patch)
 	})
 
 	return r
@@ -38,16 +37,25 @@ func New(us domain.CommentUsecase) chi.Router {
 // @Description get all comments
 // @Tags comments
 // @Produce  json
+// @Param sort query string false "sort key created_at or updated_at, prefixed with - for descending order"
+// @Param modified_since query string false "only items updated since the time" format(date-time)
 // @Success 200 {array} domain.Comment
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /comments [get]
 // Fetch will fetch comments.
 func (c *commentHandler) Fetch(w http.ResponseWriter, r *http.Request) {
-	comments, err := c.commentUsecase.Fetch(r.Context())
+	f, err := web.ParseFilter(r)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	comments, err := c.commentUsecase.Fetch(r.Context(), f)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -61,21 +69,21 @@ func (c *commentHandler) Fetch(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "comment ID" format(uuid)
 // @Success 200 {object} domain.Comment
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /comments/{id} [get]
 // GetByID will get comment by given id.
 func (c *commentHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "commentID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	comment, err := c.commentUsecase.GetByID(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -83,8 +91,7 @@ func (c *commentHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func isRequestValid(m *domain.Comment) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -101,34 +108,95 @@ func isRequestValid(m *domain.Comment) (bool, error) {
 // @Param  id path string true "comment ID" format(uuid)
 // @Param comment body domain.Comment true "Update comment"
 // @Success 200 {object} domain.Comment
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /comments/{id} [put]
 // Update will update the comment by given id and request body.
 func (c *commentHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "commentID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var comment domain.Comment
 	if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	comment.ID = id
 	if ok, err := isRequestValid(&comment); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
 	if err := c.commentUsecase.Update(r.Context(), &comment); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, comment, http.StatusOK)
+}
+
+// Patch godoc
+// @Summary Patch a comment
+// @Description update the comment by a JSON merge patch applied on top of the stored comment
+// @Tags comments
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param  id path string true "comment ID" format(uuid)
+// @Param patch body domain.Comment true "JSON merge patch of the comment"
+// @Success 200 {object} domain.Comment
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 415 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /comments/{id} [patch]
+// Patch will update the comment by the JSON merge patch of the request body.
+func (c *commentHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "commentID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if !web.IsMergePatch(r) {
+		web.RespondRequestError(w, r, web.ErrMergePatchType, http.StatusUnsupportedMediaType)
+
+		return
+	}
+	comment, err := c.commentUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := web.MergePatch(&comment, r.Body); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	comment.ID = id
+	if ok, err := isRequestValid(&comment); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := c.commentUsecase.Update(r.Context(), &comment); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	// Read the comment back to respond with the timestamps set by the database.
+	comment, err = c.commentUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -142,38 +210,23 @@ func (c *commentHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "comment ID"
 // @Success 204 "it's ok"
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /comments/{id} [delete]
 // Delete will delete comment by given param.
 func (c *commentHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "commentID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	if err := c.commentUsecase.Delete(r.Context(), id); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
-
-func getStatusCode(err error) int {
-	if err == nil {
-		return http.StatusOK
-	}
-
-	switch {
-	case errors.Is(err, domain.ErrNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrConflict):
-		return http.StatusConflict
-	default:
-		return http.StatusInternalServerError
-	}
-}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	store "github.com/igkostyuk/tasktracker/store/postgres"
 )
 
 type commentRepository struct {
@@ -33,6 +34,7 @@ func (c *commentRepository) fetch(ctx context.Context, query string, args ...int
 			&t.Text,
 			&t.TaskID,
 			&t.CreatedAt,
+			&t.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("rows scan error: %w", err)
@@ -47,18 +49,48 @@ func (c *commentRepository) fetch(ctx context.Context, query string, args ...int
 	return result, nil
 }
 
-func (c *commentRepository) Fetch(ctx context.Context) ([]domain.Comment, error) {
-	query := `SELECT id, text, task_id, date_created FROM comments ORDER BY date_created`
+func (c *commentRepository) Fetch(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
+	// nolint:gosec // order by is built from the whitelisted sort keys.
+	query := `SELECT id, text, task_id, created_at, updated_at FROM comments WHERE deleted_at IS NULL AND updated_at >= $1
+	ORDER BY ` + store.OrderBy(f, "created_at")
 
-	return c.fetch(ctx, query)
+	return c.fetch(ctx, query, f.ModifiedSince)
 }
 
 func (c *commentRepository) FetchByTaskID(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
-	query := `SELECT id, text, task_id, date_created FROM comments WHERE task_id = $1`
+	query := `SELECT id, text, task_id, created_at, updated_at FROM comments WHERE task_id = $1 AND deleted_at IS NULL
+	ORDER BY created_at`
 
 	return c.fetch(ctx, query, id)
 }
 
+func (c *commentRepository) FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+	query := `SELECT id, text, task_id, created_at, updated_at FROM comments
+	WHERE task_id = ANY($1::uuid[]) AND deleted_at IS NULL ORDER BY created_at`
+
+	return c.fetch(ctx, query, store.IDs(ids))
+}
+
+func (c *commentRepository) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+	query := `SELECT cm.id, cm.text, cm.task_id, cm.created_at, cm.updated_at FROM comments cm
+	JOIN tasks t ON cm.task_id = t.id JOIN columns c ON t.colum_id = c.id
+	WHERE c.project_id = $1 AND cm.deleted_at IS NULL ORDER BY cm.created_at`
+
+	return c.fetch(ctx, query, id)
+}
+
+// FetchRecentByProjectID returns the last limit comments of the live tasks of the project, the newest first.
+func (c *commentRepository) FetchRecentByProjectID(
+	ctx context.Context, id uuid.UUID, limit int,
+) ([]domain.Comment, error) {
+	query := `SELECT cm.id, cm.text, cm.task_id, cm.created_at, cm.updated_at FROM comments cm
+	JOIN tasks t ON cm.task_id = t.id JOIN columns c ON t.colum_id = c.id
+	WHERE c.project_id = $1 AND cm.deleted_at IS NULL AND t.deleted_at IS NULL AND c.deleted_at IS NULL
+	ORDER BY cm.created_at DESC LIMIT $2`
+
+	return c.fetch(ctx, query, id, limit)
+}
+
 func (c *commentRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Comment, error) {
 	row := c.db.QueryRowContext(ctx, query, args...)
 	res := domain.Comment{}
@@ -67,6 +99,7 @@ func (c *commentRepository) getOne(ctx context.Context, query string, args ...in
 		&res.Text,
 		&res.TaskID,
 		&res.CreatedAt,
+		&res.UpdatedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Comment{}, fmt.Errorf("comment: %w", domain.ErrNotFound)
@@ -79,14 +112,18 @@ func (c *commentRepository) getOne(ctx context.Context, query string, args ...in
 }
 
 func (c *commentRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
-	query := `SELECT id, text, task_id, date_created FROM comments WHERE id = $1`
+	query := `SELECT id, text, task_id, created_at, updated_at FROM comments WHERE id = $1 AND deleted_at IS NULL`
 
 	return c.getOne(ctx, query, id)
 }
 
 func (c *commentRepository) Update(ctx context.Context, cm *domain.Comment) error {
-	query := `UPDATE comments SET text=$2 WHERE id = $1`
-	_, err := c.db.ExecContext(ctx, query, cm.ID, cm.Text)
+	query := `UPDATE comments SET text=$2 WHERE id = $1 AND deleted_at IS NULL RETURNING updated_at`
+	row := c.db.QueryRowContext(ctx, query, cm.ID, cm.Text)
+	err := row.Scan(&cm.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("comment: %w", domain.ErrNotFound)
+	}
 	if err != nil {
 		return fmt.Errorf("update error: %w", err)
 	}
@@ -95,9 +132,9 @@ func (c *commentRepository) Update(ctx context.Context, cm *domain.Comment) erro
 }
 
 func (c *commentRepository) Store(ctx context.Context, ct *domain.Comment) error {
-	query := `INSERT INTO comments (text, task_id, date_created) VALUES ( $1, $2, $3) RETURNING id`
+	query := `INSERT INTO comments (text, task_id, created_at) VALUES ( $1, $2, $3) RETURNING id, updated_at`
 	row := c.db.QueryRowContext(ctx, query, ct.Text, ct.TaskID, ct.CreatedAt)
-	err := row.Scan(&ct.ID)
+	err := row.Scan(&ct.ID, &ct.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("store error: %w", err)
 	}
@@ -106,7 +143,7 @@ func (c *commentRepository) Store(ctx context.Context, ct *domain.Comment) error
 }
 
 func (c *commentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM comments WHERE id = $1`
+	query := `DELETE FROM comments WHERE id = $1 AND deleted_at IS NULL`
 	_, err := c.db.ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("delete error: %w", err)
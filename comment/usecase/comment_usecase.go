@@ -10,15 +10,20 @@ import (
 
 type commentUsecase struct {
 	commentRepo domain.CommentRepository
+	projectRepo domain.ProjectRepository
 }
 
 // New will create new a CommentUsecase object representation of domain.ComentUsecase interface.
-func New(c domain.CommentRepository) domain.CommentUsecase {
-	return &commentUsecase{commentRepo: c}
+func New(c domain.CommentRepository, p domain.ProjectRepository) domain.CommentUsecase {
+	return &commentUsecase{commentRepo: c, projectRepo: p}
 }
 
-func (c *commentUsecase) Fetch(ctx context.Context) ([]domain.Comment, error) {
-	return c.commentRepo.Fetch(ctx)
+func (c *commentUsecase) Fetch(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
+	return c.commentRepo.Fetch(ctx, f)
+}
+
+func (c *commentUsecase) FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+	return c.commentRepo.FetchByTaskIDs(ctx, ids)
 }
 
 func (c *commentUsecase) GetByID(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
@@ -30,15 +35,22 @@ func (c *commentUsecase) Update(ctx context.Context, cm *domain.Comment) error {
 	if err != nil {
 		return fmt.Errorf("get by id comment: %w", err)
 	}
+	if err = domain.CheckArchived(c.projectRepo.GetByTaskID(ctx, old.TaskID)); err != nil {
+		return err
+	}
 	cm.TaskID = old.TaskID
 	cm.CreatedAt = old.CreatedAt
 	return c.commentRepo.Update(ctx, cm)
 }
 
 func (c *commentUsecase) Delete(ctx context.Context, id uuid.UUID) error {
-	if _, err := c.commentRepo.GetByID(ctx, id); err != nil {
+	cm, err := c.commentRepo.GetByID(ctx, id)
+	if err != nil {
 		return fmt.Errorf("get by id comment: %w", err)
 	}
+	if err = domain.CheckArchived(c.projectRepo.GetByTaskID(ctx, cm.TaskID)); err != nil {
+		return err
+	}
 
 	return c.commentRepo.Delete(ctx, id)
 }
@@ -18,12 +18,12 @@ func TestFetch(t *testing.T) {
 	want := []domain.Comment{{Text: "test"}}
 	// nolint:exhaustivestruct
 	mockedCommentRepo := &mocks.CommentRepositoryMock{
-		FetchFunc: func(ctx context.Context) ([]domain.Comment, error) {
+		FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
 			return want, nil
 		},
 	}
-	u := commentUsecase.New(mockedCommentRepo)
-	projects, err := u.Fetch(context.TODO())
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
+	projects, err := u.Fetch(context.TODO(), domain.Filter{})
 	is.NoErr(err)
 	is.Equal(want, projects)
 	is.Equal(len(mockedCommentRepo.FetchCalls()), 1)
@@ -38,7 +38,7 @@ func TestGetByID(t *testing.T) {
 			return domain.Comment{}, nil
 		},
 	}
-	u := commentUsecase.New(mockedCommentRepo)
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
 	_, err := u.GetByID(context.TODO(), id)
 	is.NoErr(err)
 	cg := mockedCommentRepo.GetByIDCalls()
@@ -59,7 +59,7 @@ func TestUpdate(t *testing.T) {
 	}
 	// nolint:exhaustivestruct
 	comment := domain.Comment{ID: uuid.New(), Text: "test"}
-	u := commentUsecase.New(mockedCommentRepo)
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
 	err := u.Update(context.TODO(), &comment)
 	is.NoErr(err)
 	cg := mockedCommentRepo.GetByIDCalls()
@@ -82,7 +82,7 @@ func TestUpdateError(t *testing.T) {
 	}
 	// nolint:exhaustivestruct
 	comment := domain.Comment{ID: uuid.New(), Text: "test"}
-	u := commentUsecase.New(mockedCommentRepo)
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
 	err := u.Update(context.TODO(), &comment)
 	is.True(err != nil)
 	cg := mockedCommentRepo.GetByIDCalls()
@@ -104,7 +104,7 @@ func TestDelete(t *testing.T) {
 		},
 	}
 	id := uuid.New()
-	u := commentUsecase.New(mockedCommentRepo)
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
 	err := u.Delete(context.TODO(), id)
 	is.NoErr(err)
 	cg := mockedCommentRepo.GetByIDCalls()
@@ -126,7 +126,7 @@ func TestDeleteError(t *testing.T) {
 		},
 	}
 	id := uuid.New()
-	u := commentUsecase.New(mockedCommentRepo)
+	u := commentUsecase.New(mockedCommentRepo, projectRepo())
 	err := u.Delete(context.TODO(), id)
 	is.True(err != nil)
 	cg := mockedCommentRepo.GetByIDCalls()
@@ -135,3 +135,15 @@ func TestDeleteError(t *testing.T) {
 	is.Equal(cg[0].ID, id)
 	is.Equal(len(cu), 0)
 }
+
+// nolint:exhaustivestruct
+func projectRepo() *mocks.ProjectRepositoryMock {
+	return &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+		GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: uuid.New()}, nil
+		},
+	}
+}
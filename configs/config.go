@@ -11,11 +11,16 @@ type (
 	Config struct {
 		APIHost         string        `envconfig:"API_LISTEN_URL"       default:"0.0.0.0:3000"`
 		DebugHost       string        `envconfig:"API_DEBUG_URL"        default:"0.0.0.0:4000"`
+		GRPCHost        string        `envconfig:"API_GRPC_URL"         default:"0.0.0.0:5000"`
 		ReadTimeout     time.Duration `envconfig:"API_READ_TIMEOUT"     default:"5s"`
 		WriteTimeout    time.Duration `envconfig:"API_WRITE_TIMEOUT"    default:"5s"`
 		ShutdownTimeout time.Duration `envconfig:"API_SHUTDOWN_TIMEOUT" default:"5s"`
+		AdminToken      string        `envconfig:"API_ADMIN_TOKEN"`
 
 		Postgres Postgres
+		Tasks    Tasks
+		Trash    Trash
+		Report   Report
 	}
 	Postgres struct {
 		Host         string        `envconfig:"POSTGRES_HOST"              default:"0.0.0.0:5432"`
@@ -28,6 +33,16 @@ type (
 		WriteTimeout time.Duration `envconfig:"API_POSTGRES_WRITE_TIMEOUT" default:"10s"`
 		DisableTLS   bool          `envconfig:"API_POSTGRES_DISABLE_TLS"   default:"true"`
 	}
+	Tasks struct {
+		BlockDone bool `envconfig:"API_TASKS_BLOCK_DONE" default:"false"`
+	}
+	Trash struct {
+		Retention     time.Duration `envconfig:"API_TRASH_RETENTION"      default:"720h"`
+		PurgeInterval time.Duration `envconfig:"API_TRASH_PURGE_INTERVAL" default:"1h"`
+	}
+	Report struct {
+		TemplatesDir string `envconfig:"API_REPORT_TEMPLATES_DIR"`
+	}
 )
 
 // FromFile return config from file path.
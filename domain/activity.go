@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityKind represent what happened on a board.
+type ActivityKind string
+
+// The kinds of activity on a board.
+const (
+	ActivityTaskCreated  ActivityKind = "task_created"
+	ActivityTaskMoved    ActivityKind = "task_moved"
+	ActivityCommentAdded ActivityKind = "comment_added"
+)
+
+// Activity represent a task creation, a move of a task between columns or a new comment.
+// ID is the id of the task, transition or comment the activity comes from.
+// FromColumn is only set for moves and Comment only for new comments.
+type Activity struct {
+	ID         uuid.UUID    `json:"id"`
+	Kind       ActivityKind `json:"kind"`
+	Task       Task         `json:"task"`
+	Column     Column       `json:"column"`
+	FromColumn *Column      `json:"from_column,omitempty"`
+	Comment    *Comment     `json:"comment,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+// ProjectActivity represent the most recent activity of a project, the newest first.
+type ProjectActivity struct {
+	Project    Project    `json:"project"`
+	Activities []Activity `json:"activities"`
+}
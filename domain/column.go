@@ -2,39 +2,101 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 //go:generate moq -out ./mock/column.go -pkg mocks . ColumnUsecase ColumnRepository
 
+// Category represent the stage of work a column stands for.
+type Category string
+
+const (
+	// CategoryTodo is a column of tasks not started yet.
+	CategoryTodo Category = "todo"
+	// CategoryInProgress is a column of tasks being worked on.
+	CategoryInProgress Category = "in-progress"
+	// CategoryDone is a column of finished tasks.
+	CategoryDone Category = "done"
+)
+
 // Column represent a columns in tasktracker.
 type Column struct {
-	ID        uuid.UUID `json:"id" readonly:"true"`
-	Position  int       `json:"position" validate:"min=0"`
-	Name      string    `json:"name" validate:"required,min=1,max=255"`
-	Status    string    `json:"status" validate:"required,min=1,max=255"`
-	ProjectID uuid.UUID `json:"project_id" readonly:"true"`
+	ID        uuid.UUID  `json:"id" readonly:"true"`
+	Position  int        `json:"position" validate:"min=0"`
+	Name      string     `json:"name" validate:"required,min=1,max=255"`
+	Status    string     `json:"status" validate:"required,min=1,max=255"`
+	Category  Category   `json:"category" validate:"omitempty,oneof=todo in-progress done"`
+	WIPLimit  *int       `json:"wip_limit,omitempty" validate:"omitempty,min=1"`
+	ProjectID uuid.UUID  `json:"project_id" readonly:"true"`
+	CreatedAt time.Time  `json:"created_at" readonly:"true"`
+	UpdatedAt time.Time  `json:"updated_at" readonly:"true"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" readonly:"true"`
+}
+
+// WIPOverride represent a record of a task placed into a column over its WIP limit.
+type WIPOverride struct {
+	ID        uuid.UUID `json:"id"`
+	TaskID    uuid.UUID `json:"task_id"`
+	ColumnID  uuid.UUID `json:"column_id"`
+	WIPLimit  int       `json:"wip_limit"`
+	Count     int       `json:"count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type wipOverrideKey struct{}
+
+// WithWIPOverride returns a copy of ctx that allows to exceed column WIP limits.
+func WithWIPOverride(ctx context.Context) context.Context {
+	return context.WithValue(ctx, wipOverrideKey{}, true)
+}
+
+// IsWIPOverride reports whether ctx allows to exceed column WIP limits.
+func IsWIPOverride(ctx context.Context) bool {
+	override, ok := ctx.Value(wipOverrideKey{}).(bool)
+
+	return ok && override
+}
+
+// CheckWIPLimit verifies that one more task fits into the column with count tasks
+// and reports whether the limit was overridden to place it.
+func CheckWIPLimit(ctx context.Context, column *Column, count int) (bool, error) {
+	if column.WIPLimit == nil || count < *column.WIPLimit {
+		return false, nil
+	}
+	if !IsWIPOverride(ctx) {
+		return false, ErrWIPLimit
+	}
+
+	return true, nil
 }
 
 // ColumnUsecase represent the column's usecases.
 type ColumnUsecase interface {
-	Fetch(ctx context.Context) ([]Column, error)
+	Fetch(ctx context.Context, f Filter) ([]Column, error)
 	FetchByProjectID(ctx context.Context, id uuid.UUID) ([]Column, error)
+	FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]Column, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Column, error)
 	Update(ctx context.Context, cl *Column) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	FetchTasks(ctx context.Context, id uuid.UUID) ([]Task, error)
 	MoveLeft(ctx context.Context, old, cl *Column, cls []Column) error
 	MoveRight(ctx context.Context, old, cl *Column, cls []Column) error
+	Restore(ctx context.Context, id uuid.UUID) (Column, error)
 }
 
 // ColumnRepository represent the column's repository contract.
 type ColumnRepository interface {
-	Fetch(ctx context.Context) ([]Column, error)
+	Fetch(ctx context.Context, f Filter) ([]Column, error)
 	FetchByProjectID(ctx context.Context, id uuid.UUID) ([]Column, error)
+	FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]Column, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Column, error)
 	Update(ctx context.Context, cls ...Column) error
 	Store(ctx context.Context, c *Column) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]Column, error)
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (Column, error)
+	Restore(ctx context.Context, cl *Column) error
+	Purge(ctx context.Context, before time.Time) (int64, error)
 }
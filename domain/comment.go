@@ -15,11 +15,13 @@ type Comment struct {
 	Text      string    `json:"text" validate:"required,min=1,max=5000"`
 	TaskID    uuid.UUID `json:"task_id" readonly:"true"`
 	CreatedAt time.Time `json:"created_at" readonly:"true"`
+	UpdatedAt time.Time `json:"updated_at" readonly:"true"`
 }
 
 // CommentUsecase represent the comment's usecases.
 type CommentUsecase interface {
-	Fetch(ctx context.Context) ([]Comment, error)
+	Fetch(ctx context.Context, f Filter) ([]Comment, error)
+	FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]Comment, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Comment, error)
 	Update(ctx context.Context, tk *Comment) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -27,8 +29,11 @@ type CommentUsecase interface {
 
 // CommentRepository represent the comment's repository contract.
 type CommentRepository interface {
-	Fetch(ctx context.Context) ([]Comment, error)
+	Fetch(ctx context.Context, f Filter) ([]Comment, error)
 	FetchByTaskID(ctx context.Context, id uuid.UUID) ([]Comment, error)
+	FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]Comment, error)
+	FetchByProjectID(ctx context.Context, id uuid.UUID) ([]Comment, error)
+	FetchRecentByProjectID(ctx context.Context, id uuid.UUID, limit int) ([]Comment, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Comment, error)
 	Update(ctx context.Context, cm *Comment) error
 	Store(ctx context.Context, ct *Comment) error
@@ -15,4 +15,28 @@ var (
 	ErrLastColumn = errors.New("the last column cannot be deleted")
 	// ErrUnique will throw if column name or status not unique for project.
 	ErrUnique = errors.New("must be unique")
+	// ErrParentCycle will throw if the parent task is the task itself or one of its children.
+	ErrParentCycle = errors.New("task cannot be a parent of itself")
+	// ErrParentProject will throw if the parent task belongs to another project.
+	ErrParentProject = errors.New("parent task must belong to the same project")
+	// ErrLinkCycle will throw if a blocks link closes a cycle of blocking tasks.
+	ErrLinkCycle = errors.New("tasks cannot block each other in a cycle")
+	// ErrBlocked will throw if a task with open blockers is moved into a done column.
+	ErrBlocked = errors.New("task is blocked by open tasks")
+	// ErrWIPLimit will throw if a column has reached its work in progress limit.
+	ErrWIPLimit = errors.New("column work in progress limit is reached")
+	// ErrArchived will throw if trying to change the board of an archived project.
+	ErrArchived = errors.New("project is archived")
+	// ErrForbidden will throw if the action requires admin rights.
+	ErrForbidden = errors.New("action is not allowed")
 )
+
+// FieldError represent a field that failed validation.
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. columns[0].name.
+	Field string `json:"field"`
+	// Rule is the failed validation rule, e.g. required or max.
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportVersion is the version of the export document written by this tasktracker.
+const ExportVersion = 1
+
+// Export represent a project with its board as a portable document.
+type Export struct {
+	Version    int              `json:"version" validate:"required"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Project    ExportedProject  `json:"project"`
+	Columns    []ExportedColumn `json:"columns" validate:"required,min=1,dive"`
+}
+
+// ExportedProject represent the project of an export document.
+type ExportedProject struct {
+	Name        string  `json:"name" validate:"required,min=1,max=500"`
+	Description string  `json:"description" validate:"min=0,max=1000"`
+	Labels      []Label `json:"labels,omitempty" validate:"dive"`
+}
+
+// ExportedColumn represent a column of an export document with its tasks in board order.
+type ExportedColumn struct {
+	Name     string         `json:"name" validate:"required,min=1,max=255"`
+	Status   string         `json:"status" validate:"required,min=1,max=255"`
+	Category Category       `json:"category" validate:"omitempty,oneof=todo in-progress done"`
+	WIPLimit *int           `json:"wip_limit,omitempty" validate:"omitempty,min=1"`
+	Tasks    []ExportedTask `json:"tasks" validate:"dive"`
+}
+
+// ExportedTask represent a task of an export document.
+// ID only links the subtasks to their parent within the document, the imported task gets a new one.
+type ExportedTask struct {
+	ID          uuid.UUID         `json:"id" validate:"required"`
+	Name        string            `json:"name" validate:"required,min=1,max=500"`
+	Description string            `json:"description" validate:"min=0,max=5000"`
+	ParentID    *uuid.UUID        `json:"parent_id,omitempty"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	Comments    []ExportedComment `json:"comments" validate:"dive"`
+}
+
+// ExportedComment represent a comment of an export document.
+type ExportedComment struct {
+	Text      string    `json:"text" validate:"required,min=1,max=5000"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TaskRow represent a task as a row of a spreadsheet.
+// Row is the line of the row in the imported file.
+type TaskRow struct {
+	Row         int    `json:"-"`
+	Column      string `json:"column" validate:"required,min=1,max=255"`
+	Position    int    `json:"position"`
+	Name        string `json:"name" validate:"required,min=1,max=500"`
+	Description string `json:"description" validate:"min=0,max=5000"`
+	Comments    int    `json:"comments"`
+}
+
+// RowError represent a row of an imported file that was not imported.
+// Fields lists the fields of the row that failed validation.
+type RowError struct {
+	Row    int          `json:"row"`
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// TaskImport represent the result of a tasks import.
+type TaskImport struct {
+	Imported int        `json:"imported"`
+	Tasks    []Task     `json:"tasks"`
+	Errors   []RowError `json:"errors"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+const (
+	// SortCreatedAt orders items by creation time.
+	SortCreatedAt = "created_at"
+	// SortUpdatedAt orders items by last modification time.
+	SortUpdatedAt = "updated_at"
+)
+
+// Filter represent the listing options of the fetch methods.
+type Filter struct {
+	// SortBy is one of the sort keys, empty for the default order.
+	SortBy string
+	// Desc reverses the sort order.
+	Desc bool
+	// ModifiedSince keeps only items updated at or after the time.
+	ModifiedSince time.Time
+	// Archived lists archived projects along with the active ones.
+	Archived bool
+}
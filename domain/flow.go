@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Percentiles represent the distribution of durations in hours.
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P85 float64 `json:"p85"`
+	P95 float64 `json:"p95"`
+}
+
+// TaskFlow represent the lead and cycle time of a completed task in hours.
+// Lead time runs from the creation of the task and cycle time from the start of work on it,
+// the cycle time is unknown for tasks without recorded transitions.
+type TaskFlow struct {
+	TaskID      uuid.UUID `json:"task_id"`
+	Name        string    `json:"name"`
+	CompletedAt time.Time `json:"completed_at"`
+	LeadTime    float64   `json:"lead_time_hours"`
+	CycleTime   *float64  `json:"cycle_time_hours,omitempty"`
+}
+
+// ColumnFlow represent the time completed tasks spent in a column.
+type ColumnFlow struct {
+	ColumnID uuid.UUID   `json:"column_id"`
+	Name     string      `json:"name"`
+	Status   string      `json:"status"`
+	Tasks    int         `json:"tasks"`
+	Time     Percentiles `json:"time_hours"`
+}
+
+// FlowMetrics represent the timing of the project tasks completed over a date range.
+type FlowMetrics struct {
+	From      time.Time    `json:"from"`
+	To        time.Time    `json:"to"`
+	Completed int          `json:"completed"`
+	LeadTime  Percentiles  `json:"lead_time_hours"`
+	CycleTime Percentiles  `json:"cycle_time_hours"`
+	Columns   []ColumnFlow `json:"columns"`
+	Tasks     []TaskFlow   `json:"tasks"`
+}
+
+// CFDSeries represent the daily task counts of a column.
+type CFDSeries struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	Name     string    `json:"name"`
+	Status   string    `json:"status"`
+	Counts   []int     `json:"counts"`
+}
+
+// CFD represent the cumulative flow diagram of a project, the counts of each column
+// are taken at the end of the matching date.
+type CFD struct {
+	Dates   []string    `json:"dates"`
+	Columns []CFDSeries `json:"columns"`
+}
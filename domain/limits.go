@@ -0,0 +1,22 @@
+package domain
+
+// The longest texts in runes allowed by the max rules of the validate tags of the domain types,
+// they must be changed together with the tags.
+const (
+	MaxProjectName        = 500
+	MaxProjectDescription = 1000
+	MaxColumnName         = 255
+	MaxTaskName           = 500
+	MaxTaskDescription    = 5000
+	MaxCommentText        = 5000
+)
+
+// Truncate cuts s to n runes, e.g. to make the texts coming from other trackers pass validation.
+func Truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n])
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate moq -out ./mock/link.go -pkg mocks . LinkRepository
+
+// LinkType represent a type of relation between two tasks.
+type LinkType string
+
+const (
+	// LinkBlocks means the task blocks the linked task.
+	LinkBlocks LinkType = "blocks"
+	// LinkBlockedBy means the task is blocked by the linked task.
+	LinkBlockedBy LinkType = "is_blocked_by"
+	// LinkRelatesTo means the tasks are related to each other.
+	LinkRelatesTo LinkType = "relates_to"
+	// LinkDuplicates means the task duplicates the linked task.
+	LinkDuplicates LinkType = "duplicates"
+	// LinkDuplicatedBy means the task is duplicated by the linked task.
+	LinkDuplicatedBy LinkType = "is_duplicated_by"
+)
+
+// Link represent a typed link between two tasks.
+type Link struct {
+	ID           uuid.UUID `json:"id" readonly:"true"`
+	Type         LinkType  `json:"type" validate:"oneof=blocks is_blocked_by relates_to duplicates is_duplicated_by"`
+	TaskID       uuid.UUID `json:"task_id" readonly:"true"`
+	LinkedTaskID uuid.UUID `json:"linked_task_id" validate:"required"`
+}
+
+// IsInverse reports whether the link is stored from the side of the linked task.
+func (l Link) IsInverse() bool {
+	return l.Type == LinkBlockedBy || l.Type == LinkDuplicatedBy
+}
+
+// Inverse returns the same link seen from the side of the linked task.
+func (l Link) Inverse() Link {
+	inverse := Link{ID: l.ID, Type: l.Type, TaskID: l.LinkedTaskID, LinkedTaskID: l.TaskID}
+	switch l.Type {
+	case LinkBlocks:
+		inverse.Type = LinkBlockedBy
+	case LinkBlockedBy:
+		inverse.Type = LinkBlocks
+	case LinkDuplicates:
+		inverse.Type = LinkDuplicatedBy
+	case LinkDuplicatedBy:
+		inverse.Type = LinkDuplicates
+	case LinkRelatesTo:
+	}
+
+	return inverse
+}
+
+// LinkRepository represent the link's repository contract.
+type LinkRepository interface {
+	FetchByTaskID(ctx context.Context, id uuid.UUID) ([]Link, error)
+	GetByID(ctx context.Context, id uuid.UUID) (Link, error)
+	Store(ctx context.Context, l *Link) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that ColumnUsecaseMock does implement domain.ColumnUsecase.
@@ -23,12 +24,15 @@ var _ domain.ColumnUsecase = &ColumnUsecaseMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Column, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
 // 	               panic("mock out the Fetch method")
 //             },
 //             FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
 // 	               panic("mock out the FetchByProjectID method")
 //             },
+//             FetchByProjectIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+// 	               panic("mock out the FetchByProjectIDs method")
+//             },
 //             FetchTasksFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 // 	               panic("mock out the FetchTasks method")
 //             },
@@ -41,6 +45,9 @@ var _ domain.ColumnUsecase = &ColumnUsecaseMock{}
 //             MoveRightFunc: func(ctx context.Context, old *domain.Column, cl *domain.Column, cls []domain.Column) error {
 // 	               panic("mock out the MoveRight method")
 //             },
+//             RestoreFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+// 	               panic("mock out the Restore method")
+//             },
 //             UpdateFunc: func(ctx context.Context, cl *domain.Column) error {
 // 	               panic("mock out the Update method")
 //             },
@@ -55,11 +62,14 @@ type ColumnUsecaseMock struct {
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Column, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Column, error)
 
 	// FetchByProjectIDFunc mocks the FetchByProjectID method.
 	FetchByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Column, error)
 
+	// FetchByProjectIDsFunc mocks the FetchByProjectIDs method.
+	FetchByProjectIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error)
+
 	// FetchTasksFunc mocks the FetchTasks method.
 	FetchTasksFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
 
@@ -72,6 +82,9 @@ type ColumnUsecaseMock struct {
 	// MoveRightFunc mocks the MoveRight method.
 	MoveRightFunc func(ctx context.Context, old *domain.Column, cl *domain.Column, cls []domain.Column) error
 
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) (domain.Column, error)
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, cl *domain.Column) error
 
@@ -88,6 +101,8 @@ type ColumnUsecaseMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
 		}
 		// FetchByProjectID holds details about calls to the FetchByProjectID method.
 		FetchByProjectID []struct {
@@ -96,6 +111,13 @@ type ColumnUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchByProjectIDs holds details about calls to the FetchByProjectIDs method.
+		FetchByProjectIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
 		// FetchTasks holds details about calls to the FetchTasks method.
 		FetchTasks []struct {
 			// Ctx is the ctx argument value.
@@ -132,6 +154,13 @@ type ColumnUsecaseMock struct {
 			// Cls is the cls argument value.
 			Cls []domain.Column
 		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -140,14 +169,16 @@ type ColumnUsecaseMock struct {
 			Cl *domain.Column
 		}
 	}
-	lockDelete           sync.RWMutex
-	lockFetch            sync.RWMutex
-	lockFetchByProjectID sync.RWMutex
-	lockFetchTasks       sync.RWMutex
-	lockGetByID          sync.RWMutex
-	lockMoveLeft         sync.RWMutex
-	lockMoveRight        sync.RWMutex
-	lockUpdate           sync.RWMutex
+	lockDelete            sync.RWMutex
+	lockFetch             sync.RWMutex
+	lockFetchByProjectID  sync.RWMutex
+	lockFetchByProjectIDs sync.RWMutex
+	lockFetchTasks        sync.RWMutex
+	lockGetByID           sync.RWMutex
+	lockMoveLeft          sync.RWMutex
+	lockMoveRight         sync.RWMutex
+	lockRestore           sync.RWMutex
+	lockUpdate            sync.RWMutex
 }
 
 // Delete calls DeleteFunc.
@@ -186,19 +217,21 @@ func (mock *ColumnUsecaseMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *ColumnUsecaseMock) Fetch(ctx context.Context) ([]domain.Column, error) {
+func (mock *ColumnUsecaseMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
 	if mock.FetchFunc == nil {
 		panic("ColumnUsecaseMock.FetchFunc: method is nil but ColumnUsecase.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -206,9 +239,11 @@ func (mock *ColumnUsecaseMock) Fetch(ctx context.Context) ([]domain.Column, erro
 //     len(mockedColumnUsecase.FetchCalls())
 func (mock *ColumnUsecaseMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -251,6 +286,41 @@ func (mock *ColumnUsecaseMock) FetchByProjectIDCalls() []struct {
 	return calls
 }
 
+// FetchByProjectIDs calls FetchByProjectIDsFunc.
+func (mock *ColumnUsecaseMock) FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+	if mock.FetchByProjectIDsFunc == nil {
+		panic("ColumnUsecaseMock.FetchByProjectIDsFunc: method is nil but ColumnUsecase.FetchByProjectIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFetchByProjectIDs.Lock()
+	mock.calls.FetchByProjectIDs = append(mock.calls.FetchByProjectIDs, callInfo)
+	mock.lockFetchByProjectIDs.Unlock()
+	return mock.FetchByProjectIDsFunc(ctx, ids)
+}
+
+// FetchByProjectIDsCalls gets all the calls that were made to FetchByProjectIDs.
+// Check the length with:
+//     len(mockedColumnUsecase.FetchByProjectIDsCalls())
+func (mock *ColumnUsecaseMock) FetchByProjectIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockFetchByProjectIDs.RLock()
+	calls = mock.calls.FetchByProjectIDs
+	mock.lockFetchByProjectIDs.RUnlock()
+	return calls
+}
+
 // FetchTasks calls FetchTasksFunc.
 func (mock *ColumnUsecaseMock) FetchTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 	if mock.FetchTasksFunc == nil {
@@ -407,6 +477,41 @@ func (mock *ColumnUsecaseMock) MoveRightCalls() []struct {
 	return calls
 }
 
+// Restore calls RestoreFunc.
+func (mock *ColumnUsecaseMock) Restore(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	if mock.RestoreFunc == nil {
+		panic("ColumnUsecaseMock.RestoreFunc: method is nil but ColumnUsecase.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, id)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedColumnUsecase.RestoreCalls())
+func (mock *ColumnUsecaseMock) RestoreCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *ColumnUsecaseMock) Update(ctx context.Context, cl *domain.Column) error {
 	if mock.UpdateFunc == nil {
@@ -455,15 +560,30 @@ var _ domain.ColumnRepository = &ColumnRepositoryMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Column, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
 // 	               panic("mock out the Fetch method")
 //             },
 //             FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
 // 	               panic("mock out the FetchByProjectID method")
 //             },
+//             FetchByProjectIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+// 	               panic("mock out the FetchByProjectIDs method")
+//             },
+//             FetchDeletedByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+// 	               panic("mock out the FetchDeletedByProjectID method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
 // 	               panic("mock out the GetByID method")
 //             },
+//             GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+// 	               panic("mock out the GetDeletedByID method")
+//             },
+//             PurgeFunc: func(ctx context.Context, before time.Time) (int64, error) {
+// 	               panic("mock out the Purge method")
+//             },
+//             RestoreFunc: func(ctx context.Context, cl *domain.Column) error {
+// 	               panic("mock out the Restore method")
+//             },
 //             StoreFunc: func(ctx context.Context, c *domain.Column) error {
 // 	               panic("mock out the Store method")
 //             },
@@ -481,14 +601,29 @@ type ColumnRepositoryMock struct {
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Column, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Column, error)
 
 	// FetchByProjectIDFunc mocks the FetchByProjectID method.
 	FetchByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Column, error)
 
+	// FetchByProjectIDsFunc mocks the FetchByProjectIDs method.
+	FetchByProjectIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error)
+
+	// FetchDeletedByProjectIDFunc mocks the FetchDeletedByProjectID method.
+	FetchDeletedByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Column, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Column, error)
 
+	// GetDeletedByIDFunc mocks the GetDeletedByID method.
+	GetDeletedByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Column, error)
+
+	// PurgeFunc mocks the Purge method.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, cl *domain.Column) error
+
 	// StoreFunc mocks the Store method.
 	StoreFunc func(ctx context.Context, c *domain.Column) error
 
@@ -508,6 +643,8 @@ type ColumnRepositoryMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
 		}
 		// FetchByProjectID holds details about calls to the FetchByProjectID method.
 		FetchByProjectID []struct {
@@ -516,6 +653,20 @@ type ColumnRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchByProjectIDs holds details about calls to the FetchByProjectIDs method.
+		FetchByProjectIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
+		// FetchDeletedByProjectID holds details about calls to the FetchDeletedByProjectID method.
+		FetchDeletedByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -523,6 +674,27 @@ type ColumnRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// GetDeletedByID holds details about calls to the GetDeletedByID method.
+		GetDeletedByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Purge holds details about calls to the Purge method.
+		Purge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cl is the cl argument value.
+			Cl *domain.Column
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// Ctx is the ctx argument value.
@@ -538,12 +710,17 @@ type ColumnRepositoryMock struct {
 			Cls []domain.Column
 		}
 	}
-	lockDelete           sync.RWMutex
-	lockFetch            sync.RWMutex
-	lockFetchByProjectID sync.RWMutex
-	lockGetByID          sync.RWMutex
-	lockStore            sync.RWMutex
-	lockUpdate           sync.RWMutex
+	lockDelete                  sync.RWMutex
+	lockFetch                   sync.RWMutex
+	lockFetchByProjectID        sync.RWMutex
+	lockFetchByProjectIDs       sync.RWMutex
+	lockFetchDeletedByProjectID sync.RWMutex
+	lockGetByID                 sync.RWMutex
+	lockGetDeletedByID          sync.RWMutex
+	lockPurge                   sync.RWMutex
+	lockRestore                 sync.RWMutex
+	lockStore                   sync.RWMutex
+	lockUpdate                  sync.RWMutex
 }
 
 // Delete calls DeleteFunc.
@@ -582,19 +759,21 @@ func (mock *ColumnRepositoryMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *ColumnRepositoryMock) Fetch(ctx context.Context) ([]domain.Column, error) {
+func (mock *ColumnRepositoryMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Column, error) {
 	if mock.FetchFunc == nil {
 		panic("ColumnRepositoryMock.FetchFunc: method is nil but ColumnRepository.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -602,9 +781,11 @@ func (mock *ColumnRepositoryMock) Fetch(ctx context.Context) ([]domain.Column, e
 //     len(mockedColumnRepository.FetchCalls())
 func (mock *ColumnRepositoryMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -647,6 +828,76 @@ func (mock *ColumnRepositoryMock) FetchByProjectIDCalls() []struct {
 	return calls
 }
 
+// FetchByProjectIDs calls FetchByProjectIDsFunc.
+func (mock *ColumnRepositoryMock) FetchByProjectIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+	if mock.FetchByProjectIDsFunc == nil {
+		panic("ColumnRepositoryMock.FetchByProjectIDsFunc: method is nil but ColumnRepository.FetchByProjectIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFetchByProjectIDs.Lock()
+	mock.calls.FetchByProjectIDs = append(mock.calls.FetchByProjectIDs, callInfo)
+	mock.lockFetchByProjectIDs.Unlock()
+	return mock.FetchByProjectIDsFunc(ctx, ids)
+}
+
+// FetchByProjectIDsCalls gets all the calls that were made to FetchByProjectIDs.
+// Check the length with:
+//     len(mockedColumnRepository.FetchByProjectIDsCalls())
+func (mock *ColumnRepositoryMock) FetchByProjectIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockFetchByProjectIDs.RLock()
+	calls = mock.calls.FetchByProjectIDs
+	mock.lockFetchByProjectIDs.RUnlock()
+	return calls
+}
+
+// FetchDeletedByProjectID calls FetchDeletedByProjectIDFunc.
+func (mock *ColumnRepositoryMock) FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+	if mock.FetchDeletedByProjectIDFunc == nil {
+		panic("ColumnRepositoryMock.FetchDeletedByProjectIDFunc: method is nil but ColumnRepository.FetchDeletedByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchDeletedByProjectID.Lock()
+	mock.calls.FetchDeletedByProjectID = append(mock.calls.FetchDeletedByProjectID, callInfo)
+	mock.lockFetchDeletedByProjectID.Unlock()
+	return mock.FetchDeletedByProjectIDFunc(ctx, id)
+}
+
+// FetchDeletedByProjectIDCalls gets all the calls that were made to FetchDeletedByProjectID.
+// Check the length with:
+//     len(mockedColumnRepository.FetchDeletedByProjectIDCalls())
+func (mock *ColumnRepositoryMock) FetchDeletedByProjectIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchDeletedByProjectID.RLock()
+	calls = mock.calls.FetchDeletedByProjectID
+	mock.lockFetchDeletedByProjectID.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *ColumnRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Column, error) {
 	if mock.GetByIDFunc == nil {
@@ -682,6 +933,111 @@ func (mock *ColumnRepositoryMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// GetDeletedByID calls GetDeletedByIDFunc.
+func (mock *ColumnRepositoryMock) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	if mock.GetDeletedByIDFunc == nil {
+		panic("ColumnRepositoryMock.GetDeletedByIDFunc: method is nil but ColumnRepository.GetDeletedByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDeletedByID.Lock()
+	mock.calls.GetDeletedByID = append(mock.calls.GetDeletedByID, callInfo)
+	mock.lockGetDeletedByID.Unlock()
+	return mock.GetDeletedByIDFunc(ctx, id)
+}
+
+// GetDeletedByIDCalls gets all the calls that were made to GetDeletedByID.
+// Check the length with:
+//     len(mockedColumnRepository.GetDeletedByIDCalls())
+func (mock *ColumnRepositoryMock) GetDeletedByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetDeletedByID.RLock()
+	calls = mock.calls.GetDeletedByID
+	mock.lockGetDeletedByID.RUnlock()
+	return calls
+}
+
+// Purge calls PurgeFunc.
+func (mock *ColumnRepositoryMock) Purge(ctx context.Context, before time.Time) (int64, error) {
+	if mock.PurgeFunc == nil {
+		panic("ColumnRepositoryMock.PurgeFunc: method is nil but ColumnRepository.Purge was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockPurge.Lock()
+	mock.calls.Purge = append(mock.calls.Purge, callInfo)
+	mock.lockPurge.Unlock()
+	return mock.PurgeFunc(ctx, before)
+}
+
+// PurgeCalls gets all the calls that were made to Purge.
+// Check the length with:
+//     len(mockedColumnRepository.PurgeCalls())
+func (mock *ColumnRepositoryMock) PurgeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockPurge.RLock()
+	calls = mock.calls.Purge
+	mock.lockPurge.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *ColumnRepositoryMock) Restore(ctx context.Context, cl *domain.Column) error {
+	if mock.RestoreFunc == nil {
+		panic("ColumnRepositoryMock.RestoreFunc: method is nil but ColumnRepository.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Cl  *domain.Column
+	}{
+		Ctx: ctx,
+		Cl:  cl,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, cl)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedColumnRepository.RestoreCalls())
+func (mock *ColumnRepositoryMock) RestoreCalls() []struct {
+	Ctx context.Context
+	Cl  *domain.Column
+} {
+	var calls []struct {
+		Ctx context.Context
+		Cl  *domain.Column
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
 func (mock *ColumnRepositoryMock) Store(ctx context.Context, c *domain.Column) error {
 	if mock.StoreFunc == nil {
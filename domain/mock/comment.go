@@ -23,11 +23,11 @@ var _ domain.CommentUsecase = &CommentUsecaseMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Comment, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
 // 	               panic("mock out the Fetch method")
 //             },
-//             FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
-// 	               panic("mock out the FetchByTaskID method")
+//             FetchByTaskIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+// 	               panic("mock out the FetchByTaskIDs method")
 //             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
 // 	               panic("mock out the GetByID method")
@@ -46,10 +46,10 @@ type CommentUsecaseMock struct {
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Comment, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Comment, error)
 
-	// FetchByTaskIDFunc mocks the FetchByTaskID method.
-	FetchByTaskIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error)
+	// FetchByTaskIDsFunc mocks the FetchByTaskIDs method.
+	FetchByTaskIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error)
 
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Comment, error)
@@ -70,13 +70,15 @@ type CommentUsecaseMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
 		}
-		// FetchByTaskID holds details about calls to the FetchByTaskID method.
-		FetchByTaskID []struct {
+		// FetchByTaskIDs holds details about calls to the FetchByTaskIDs method.
+		FetchByTaskIDs []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// ID is the id argument value.
-			ID uuid.UUID
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
 		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
@@ -93,11 +95,11 @@ type CommentUsecaseMock struct {
 			Tk *domain.Comment
 		}
 	}
-	lockDelete        sync.RWMutex
-	lockFetch         sync.RWMutex
-	lockFetchByTaskID sync.RWMutex
-	lockGetByID       sync.RWMutex
-	lockUpdate        sync.RWMutex
+	lockDelete         sync.RWMutex
+	lockFetch          sync.RWMutex
+	lockFetchByTaskIDs sync.RWMutex
+	lockGetByID        sync.RWMutex
+	lockUpdate         sync.RWMutex
 }
 
 // Delete calls DeleteFunc.
@@ -136,19 +138,21 @@ func (mock *CommentUsecaseMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *CommentUsecaseMock) Fetch(ctx context.Context) ([]domain.Comment, error) {
+func (mock *CommentUsecaseMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
 	if mock.FetchFunc == nil {
 		panic("CommentUsecaseMock.FetchFunc: method is nil but CommentUsecase.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -156,9 +160,11 @@ func (mock *CommentUsecaseMock) Fetch(ctx context.Context) ([]domain.Comment, er
 //     len(mockedCommentUsecase.FetchCalls())
 func (mock *CommentUsecaseMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -166,38 +172,38 @@ func (mock *CommentUsecaseMock) FetchCalls() []struct {
 	return calls
 }
 
-// FetchByTaskID calls FetchByTaskIDFunc.
-func (mock *CommentUsecaseMock) FetchByTaskID(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
-	if mock.FetchByTaskIDFunc == nil {
-		panic("CommentUsecaseMock.FetchByTaskIDFunc: method is nil but CommentUsecase.FetchByTaskID was just called")
+// FetchByTaskIDs calls FetchByTaskIDsFunc.
+func (mock *CommentUsecaseMock) FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+	if mock.FetchByTaskIDsFunc == nil {
+		panic("CommentUsecaseMock.FetchByTaskIDsFunc: method is nil but CommentUsecase.FetchByTaskIDs was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
-		ID  uuid.UUID
+		Ids []uuid.UUID
 	}{
 		Ctx: ctx,
-		ID:  id,
+		Ids: ids,
 	}
-	mock.lockFetchByTaskID.Lock()
-	mock.calls.FetchByTaskID = append(mock.calls.FetchByTaskID, callInfo)
-	mock.lockFetchByTaskID.Unlock()
-	return mock.FetchByTaskIDFunc(ctx, id)
+	mock.lockFetchByTaskIDs.Lock()
+	mock.calls.FetchByTaskIDs = append(mock.calls.FetchByTaskIDs, callInfo)
+	mock.lockFetchByTaskIDs.Unlock()
+	return mock.FetchByTaskIDsFunc(ctx, ids)
 }
 
-// FetchByTaskIDCalls gets all the calls that were made to FetchByTaskID.
+// FetchByTaskIDsCalls gets all the calls that were made to FetchByTaskIDs.
 // Check the length with:
-//     len(mockedCommentUsecase.FetchByTaskIDCalls())
-func (mock *CommentUsecaseMock) FetchByTaskIDCalls() []struct {
+//     len(mockedCommentUsecase.FetchByTaskIDsCalls())
+func (mock *CommentUsecaseMock) FetchByTaskIDsCalls() []struct {
 	Ctx context.Context
-	ID  uuid.UUID
+	Ids []uuid.UUID
 } {
 	var calls []struct {
 		Ctx context.Context
-		ID  uuid.UUID
+		Ids []uuid.UUID
 	}
-	mock.lockFetchByTaskID.RLock()
-	calls = mock.calls.FetchByTaskID
-	mock.lockFetchByTaskID.RUnlock()
+	mock.lockFetchByTaskIDs.RLock()
+	calls = mock.calls.FetchByTaskIDs
+	mock.lockFetchByTaskIDs.RUnlock()
 	return calls
 }
 
@@ -284,12 +290,21 @@ var _ domain.CommentRepository = &CommentRepositoryMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Comment, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
 // 	               panic("mock out the Fetch method")
 //             },
+//             FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+// 	               panic("mock out the FetchByProjectID method")
+//             },
 //             FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
 // 	               panic("mock out the FetchByTaskID method")
 //             },
+//             FetchByTaskIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+// 	               panic("mock out the FetchByTaskIDs method")
+//             },
+//             FetchRecentByProjectIDFunc: func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Comment, error) {
+// 	               panic("mock out the FetchRecentByProjectID method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
 // 	               panic("mock out the GetByID method")
 //             },
@@ -310,11 +325,20 @@ type CommentRepositoryMock struct {
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Comment, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Comment, error)
+
+	// FetchByProjectIDFunc mocks the FetchByProjectID method.
+	FetchByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error)
 
 	// FetchByTaskIDFunc mocks the FetchByTaskID method.
 	FetchByTaskIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error)
 
+	// FetchByTaskIDsFunc mocks the FetchByTaskIDs method.
+	FetchByTaskIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error)
+
+	// FetchRecentByProjectIDFunc mocks the FetchRecentByProjectID method.
+	FetchRecentByProjectIDFunc func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Comment, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Comment, error)
 
@@ -337,6 +361,15 @@ type CommentRepositoryMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
+		}
+		// FetchByProjectID holds details about calls to the FetchByProjectID method.
+		FetchByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
 		}
 		// FetchByTaskID holds details about calls to the FetchByTaskID method.
 		FetchByTaskID []struct {
@@ -345,6 +378,22 @@ type CommentRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchByTaskIDs holds details about calls to the FetchByTaskIDs method.
+		FetchByTaskIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
+		// FetchRecentByProjectID holds details about calls to the FetchRecentByProjectID method.
+		FetchRecentByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -367,12 +416,15 @@ type CommentRepositoryMock struct {
 			Cm *domain.Comment
 		}
 	}
-	lockDelete        sync.RWMutex
-	lockFetch         sync.RWMutex
-	lockFetchByTaskID sync.RWMutex
-	lockGetByID       sync.RWMutex
-	lockStore         sync.RWMutex
-	lockUpdate        sync.RWMutex
+	lockDelete                 sync.RWMutex
+	lockFetch                  sync.RWMutex
+	lockFetchByProjectID       sync.RWMutex
+	lockFetchByTaskID          sync.RWMutex
+	lockFetchByTaskIDs         sync.RWMutex
+	lockFetchRecentByProjectID sync.RWMutex
+	lockGetByID                sync.RWMutex
+	lockStore                  sync.RWMutex
+	lockUpdate                 sync.RWMutex
 }
 
 // Delete calls DeleteFunc.
@@ -411,19 +463,21 @@ func (mock *CommentRepositoryMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *CommentRepositoryMock) Fetch(ctx context.Context) ([]domain.Comment, error) {
+func (mock *CommentRepositoryMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Comment, error) {
 	if mock.FetchFunc == nil {
 		panic("CommentRepositoryMock.FetchFunc: method is nil but CommentRepository.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -431,9 +485,11 @@ func (mock *CommentRepositoryMock) Fetch(ctx context.Context) ([]domain.Comment,
 //     len(mockedCommentRepository.FetchCalls())
 func (mock *CommentRepositoryMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -441,6 +497,41 @@ func (mock *CommentRepositoryMock) FetchCalls() []struct {
 	return calls
 }
 
+// FetchByProjectID calls FetchByProjectIDFunc.
+func (mock *CommentRepositoryMock) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+	if mock.FetchByProjectIDFunc == nil {
+		panic("CommentRepositoryMock.FetchByProjectIDFunc: method is nil but CommentRepository.FetchByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchByProjectID.Lock()
+	mock.calls.FetchByProjectID = append(mock.calls.FetchByProjectID, callInfo)
+	mock.lockFetchByProjectID.Unlock()
+	return mock.FetchByProjectIDFunc(ctx, id)
+}
+
+// FetchByProjectIDCalls gets all the calls that were made to FetchByProjectID.
+// Check the length with:
+//     len(mockedCommentRepository.FetchByProjectIDCalls())
+func (mock *CommentRepositoryMock) FetchByProjectIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchByProjectID.RLock()
+	calls = mock.calls.FetchByProjectID
+	mock.lockFetchByProjectID.RUnlock()
+	return calls
+}
+
 // FetchByTaskID calls FetchByTaskIDFunc.
 func (mock *CommentRepositoryMock) FetchByTaskID(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
 	if mock.FetchByTaskIDFunc == nil {
@@ -476,6 +567,80 @@ func (mock *CommentRepositoryMock) FetchByTaskIDCalls() []struct {
 	return calls
 }
 
+// FetchByTaskIDs calls FetchByTaskIDsFunc.
+func (mock *CommentRepositoryMock) FetchByTaskIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+	if mock.FetchByTaskIDsFunc == nil {
+		panic("CommentRepositoryMock.FetchByTaskIDsFunc: method is nil but CommentRepository.FetchByTaskIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFetchByTaskIDs.Lock()
+	mock.calls.FetchByTaskIDs = append(mock.calls.FetchByTaskIDs, callInfo)
+	mock.lockFetchByTaskIDs.Unlock()
+	return mock.FetchByTaskIDsFunc(ctx, ids)
+}
+
+// FetchByTaskIDsCalls gets all the calls that were made to FetchByTaskIDs.
+// Check the length with:
+//     len(mockedCommentRepository.FetchByTaskIDsCalls())
+func (mock *CommentRepositoryMock) FetchByTaskIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockFetchByTaskIDs.RLock()
+	calls = mock.calls.FetchByTaskIDs
+	mock.lockFetchByTaskIDs.RUnlock()
+	return calls
+}
+
+// FetchRecentByProjectID calls FetchRecentByProjectIDFunc.
+func (mock *CommentRepositoryMock) FetchRecentByProjectID(ctx context.Context, id uuid.UUID, limit int) ([]domain.Comment, error) {
+	if mock.FetchRecentByProjectIDFunc == nil {
+		panic("CommentRepositoryMock.FetchRecentByProjectIDFunc: method is nil but CommentRepository.FetchRecentByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Limit int
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Limit: limit,
+	}
+	mock.lockFetchRecentByProjectID.Lock()
+	mock.calls.FetchRecentByProjectID = append(mock.calls.FetchRecentByProjectID, callInfo)
+	mock.lockFetchRecentByProjectID.Unlock()
+	return mock.FetchRecentByProjectIDFunc(ctx, id, limit)
+}
+
+// FetchRecentByProjectIDCalls gets all the calls that were made to FetchRecentByProjectID.
+// Check the length with:
+//     len(mockedCommentRepository.FetchRecentByProjectIDCalls())
+func (mock *CommentRepositoryMock) FetchRecentByProjectIDCalls() []struct {
+	Ctx   context.Context
+	ID    uuid.UUID
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Limit int
+	}
+	mock.lockFetchRecentByProjectID.RLock()
+	calls = mock.calls.FetchRecentByProjectID
+	mock.lockFetchRecentByProjectID.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *CommentRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
 	if mock.GetByIDFunc == nil {
@@ -0,0 +1,229 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	"sync"
+)
+
+// Ensure, that LinkRepositoryMock does implement domain.LinkRepository.
+// If this is not the case, regenerate this file with moq.
+var _ domain.LinkRepository = &LinkRepositoryMock{}
+
+// LinkRepositoryMock is a mock implementation of domain.LinkRepository.
+//
+//     func TestSomethingThatUsesLinkRepository(t *testing.T) {
+//
+//         // make and configure a mocked domain.LinkRepository
+//         mockedLinkRepository := &LinkRepositoryMock{
+//             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+// 	               panic("mock out the Delete method")
+//             },
+//             FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+// 	               panic("mock out the FetchByTaskID method")
+//             },
+//             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Link, error) {
+// 	               panic("mock out the GetByID method")
+//             },
+//             StoreFunc: func(ctx context.Context, l *domain.Link) error {
+// 	               panic("mock out the Store method")
+//             },
+//         }
+//
+//         // use mockedLinkRepository in code that requires domain.LinkRepository
+//         // and then make assertions.
+//
+//     }
+type LinkRepositoryMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	// FetchByTaskIDFunc mocks the FetchByTaskID method.
+	FetchByTaskIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Link, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Link, error)
+
+	// StoreFunc mocks the Store method.
+	StoreFunc func(ctx context.Context, l *domain.Link) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// FetchByTaskID holds details about calls to the FetchByTaskID method.
+		FetchByTaskID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// L is the l argument value.
+			L *domain.Link
+		}
+	}
+	lockDelete        sync.RWMutex
+	lockFetchByTaskID sync.RWMutex
+	lockGetByID       sync.RWMutex
+	lockStore         sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *LinkRepositoryMock) Delete(ctx context.Context, id uuid.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("LinkRepositoryMock.DeleteFunc: method is nil but LinkRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//     len(mockedLinkRepository.DeleteCalls())
+func (mock *LinkRepositoryMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// FetchByTaskID calls FetchByTaskIDFunc.
+func (mock *LinkRepositoryMock) FetchByTaskID(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+	if mock.FetchByTaskIDFunc == nil {
+		panic("LinkRepositoryMock.FetchByTaskIDFunc: method is nil but LinkRepository.FetchByTaskID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchByTaskID.Lock()
+	mock.calls.FetchByTaskID = append(mock.calls.FetchByTaskID, callInfo)
+	mock.lockFetchByTaskID.Unlock()
+	return mock.FetchByTaskIDFunc(ctx, id)
+}
+
+// FetchByTaskIDCalls gets all the calls that were made to FetchByTaskID.
+// Check the length with:
+//     len(mockedLinkRepository.FetchByTaskIDCalls())
+func (mock *LinkRepositoryMock) FetchByTaskIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchByTaskID.RLock()
+	calls = mock.calls.FetchByTaskID
+	mock.lockFetchByTaskID.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *LinkRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Link, error) {
+	if mock.GetByIDFunc == nil {
+		panic("LinkRepositoryMock.GetByIDFunc: method is nil but LinkRepository.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//     len(mockedLinkRepository.GetByIDCalls())
+func (mock *LinkRepositoryMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Store calls StoreFunc.
+func (mock *LinkRepositoryMock) Store(ctx context.Context, l *domain.Link) error {
+	if mock.StoreFunc == nil {
+		panic("LinkRepositoryMock.StoreFunc: method is nil but LinkRepository.Store was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		L   *domain.Link
+	}{
+		Ctx: ctx,
+		L:   l,
+	}
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(ctx, l)
+}
+
+// StoreCalls gets all the calls that were made to Store.
+// Check the length with:
+//     len(mockedLinkRepository.StoreCalls())
+func (mock *LinkRepositoryMock) StoreCalls() []struct {
+	Ctx context.Context
+	L   *domain.Link
+} {
+	var calls []struct {
+		Ctx context.Context
+		L   *domain.Link
+	}
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
+	return calls
+}
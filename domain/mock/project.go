@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that ProjectUsecaseMock does implement domain.ProjectUsecase.
@@ -20,27 +21,72 @@ var _ domain.ProjectUsecase = &ProjectUsecaseMock{}
 //
 //         // make and configure a mocked domain.ProjectUsecase
 //         mockedProjectUsecase := &ProjectUsecaseMock{
+//             ArchiveFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+// 	               panic("mock out the Archive method")
+//             },
+//             CloneFunc: func(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error) {
+// 	               panic("mock out the Clone method")
+//             },
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Project, error) {
+//             ExportFunc: func(ctx context.Context, id uuid.UUID) (domain.Export, error) {
+// 	               panic("mock out the Export method")
+//             },
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 // 	               panic("mock out the Fetch method")
 //             },
+//             FetchActivityFunc: func(ctx context.Context, id uuid.UUID) (domain.ProjectActivity, error) {
+// 	               panic("mock out the FetchActivity method")
+//             },
+//             FetchCFDFunc: func(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.CFD, error) {
+// 	               panic("mock out the FetchCFD method")
+//             },
 //             FetchColumnsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
 // 	               panic("mock out the FetchColumns method")
 //             },
+//             FetchDeletedFunc: func(ctx context.Context) ([]domain.Project, error) {
+// 	               panic("mock out the FetchDeleted method")
+//             },
+//             FetchFlowMetricsFunc: func(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.FlowMetrics, error) {
+// 	               panic("mock out the FetchFlowMetrics method")
+//             },
+//             FetchReportFunc: func(ctx context.Context, id uuid.UUID) (domain.Report, error) {
+// 	               panic("mock out the FetchReport method")
+//             },
+//             FetchTaskRowsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.TaskRow, error) {
+// 	               panic("mock out the FetchTaskRows method")
+//             },
 //             FetchTasksFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 // 	               panic("mock out the FetchTasks method")
 //             },
+//             FetchTrashFunc: func(ctx context.Context, id uuid.UUID) (domain.Trash, error) {
+// 	               panic("mock out the FetchTrash method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
 // 	               panic("mock out the GetByID method")
 //             },
+//             ImportFunc: func(ctx context.Context, ex *domain.Export) (domain.Project, error) {
+// 	               panic("mock out the Import method")
+//             },
+//             ImportTasksFunc: func(ctx context.Context, id uuid.UUID, rows []domain.TaskRow) (domain.TaskImport, error) {
+// 	               panic("mock out the ImportTasks method")
+//             },
+//             RestoreFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+// 	               panic("mock out the Restore method")
+//             },
 //             StoreFunc: func(in1 context.Context, in2 *domain.Project) error {
 // 	               panic("mock out the Store method")
 //             },
 //             StoreColumnFunc: func(in1 context.Context, in2 *domain.Column) error {
 // 	               panic("mock out the StoreColumn method")
 //             },
+//             StoreFromTemplateFunc: func(ctx context.Context, pr *domain.Project, templateID uuid.UUID) error {
+// 	               panic("mock out the StoreFromTemplate method")
+//             },
+//             UnarchiveFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+// 	               panic("mock out the Unarchive method")
+//             },
 //             UpdateFunc: func(ctx context.Context, pr *domain.Project) error {
 // 	               panic("mock out the Update method")
 //             },
@@ -51,32 +97,93 @@ var _ domain.ProjectUsecase = &ProjectUsecaseMock{}
 //
 //     }
 type ProjectUsecaseMock struct {
+	// ArchiveFunc mocks the Archive method.
+	ArchiveFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
+
+	// CloneFunc mocks the Clone method.
+	CloneFunc func(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error)
+
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
+	// ExportFunc mocks the Export method.
+	ExportFunc func(ctx context.Context, id uuid.UUID) (domain.Export, error)
+
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Project, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Project, error)
+
+	// FetchActivityFunc mocks the FetchActivity method.
+	FetchActivityFunc func(ctx context.Context, id uuid.UUID) (domain.ProjectActivity, error)
+
+	// FetchCFDFunc mocks the FetchCFD method.
+	FetchCFDFunc func(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.CFD, error)
 
 	// FetchColumnsFunc mocks the FetchColumns method.
 	FetchColumnsFunc func(ctx context.Context, id uuid.UUID) ([]domain.Column, error)
 
+	// FetchDeletedFunc mocks the FetchDeleted method.
+	FetchDeletedFunc func(ctx context.Context) ([]domain.Project, error)
+
+	// FetchFlowMetricsFunc mocks the FetchFlowMetrics method.
+	FetchFlowMetricsFunc func(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.FlowMetrics, error)
+
+	// FetchReportFunc mocks the FetchReport method.
+	FetchReportFunc func(ctx context.Context, id uuid.UUID) (domain.Report, error)
+
+	// FetchTaskRowsFunc mocks the FetchTaskRows method.
+	FetchTaskRowsFunc func(ctx context.Context, id uuid.UUID) ([]domain.TaskRow, error)
+
 	// FetchTasksFunc mocks the FetchTasks method.
 	FetchTasksFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
 
+	// FetchTrashFunc mocks the FetchTrash method.
+	FetchTrashFunc func(ctx context.Context, id uuid.UUID) (domain.Trash, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
 
+	// ImportFunc mocks the Import method.
+	ImportFunc func(ctx context.Context, ex *domain.Export) (domain.Project, error)
+
+	// ImportTasksFunc mocks the ImportTasks method.
+	ImportTasksFunc func(ctx context.Context, id uuid.UUID, rows []domain.TaskRow) (domain.TaskImport, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
+
 	// StoreFunc mocks the Store method.
 	StoreFunc func(in1 context.Context, in2 *domain.Project) error
 
 	// StoreColumnFunc mocks the StoreColumn method.
 	StoreColumnFunc func(in1 context.Context, in2 *domain.Column) error
 
+	// StoreFromTemplateFunc mocks the StoreFromTemplate method.
+	StoreFromTemplateFunc func(ctx context.Context, pr *domain.Project, templateID uuid.UUID) error
+
+	// UnarchiveFunc mocks the Unarchive method.
+	UnarchiveFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, pr *domain.Project) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Archive holds details about calls to the Archive method.
+		Archive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Clone holds details about calls to the Clone method.
+		Clone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Opts is the opts argument value.
+			Opts domain.CloneOptions
+		}
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
 			// Ctx is the ctx argument value.
@@ -84,10 +191,37 @@ type ProjectUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// Export holds details about calls to the Export method.
+		Export []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Fetch holds details about calls to the Fetch method.
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
+		}
+		// FetchActivity holds details about calls to the FetchActivity method.
+		FetchActivity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// FetchCFD holds details about calls to the FetchCFD method.
+		FetchCFD []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
 		}
 		// FetchColumns holds details about calls to the FetchColumns method.
 		FetchColumns []struct {
@@ -96,6 +230,36 @@ type ProjectUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchDeleted holds details about calls to the FetchDeleted method.
+		FetchDeleted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// FetchFlowMetrics holds details about calls to the FetchFlowMetrics method.
+		FetchFlowMetrics []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// FetchReport holds details about calls to the FetchReport method.
+		FetchReport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// FetchTaskRows holds details about calls to the FetchTaskRows method.
+		FetchTaskRows []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// FetchTasks holds details about calls to the FetchTasks method.
 		FetchTasks []struct {
 			// Ctx is the ctx argument value.
@@ -103,6 +267,13 @@ type ProjectUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchTrash holds details about calls to the FetchTrash method.
+		FetchTrash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -110,6 +281,29 @@ type ProjectUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// Import holds details about calls to the Import method.
+		Import []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ex is the ex argument value.
+			Ex *domain.Export
+		}
+		// ImportTasks holds details about calls to the ImportTasks method.
+		ImportTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Rows is the rows argument value.
+			Rows []domain.TaskRow
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// In1 is the in1 argument value.
@@ -124,6 +318,22 @@ type ProjectUsecaseMock struct {
 			// In2 is the in2 argument value.
 			In2 *domain.Column
 		}
+		// StoreFromTemplate holds details about calls to the StoreFromTemplate method.
+		StoreFromTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pr is the pr argument value.
+			Pr *domain.Project
+			// TemplateID is the templateID argument value.
+			TemplateID uuid.UUID
+		}
+		// Unarchive holds details about calls to the Unarchive method.
+		Unarchive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -132,14 +342,103 @@ type ProjectUsecaseMock struct {
 			Pr *domain.Project
 		}
 	}
-	lockDelete       sync.RWMutex
-	lockFetch        sync.RWMutex
-	lockFetchColumns sync.RWMutex
-	lockFetchTasks   sync.RWMutex
-	lockGetByID      sync.RWMutex
-	lockStore        sync.RWMutex
-	lockStoreColumn  sync.RWMutex
-	lockUpdate       sync.RWMutex
+	lockArchive           sync.RWMutex
+	lockClone             sync.RWMutex
+	lockDelete            sync.RWMutex
+	lockExport            sync.RWMutex
+	lockFetch             sync.RWMutex
+	lockFetchActivity     sync.RWMutex
+	lockFetchCFD          sync.RWMutex
+	lockFetchColumns      sync.RWMutex
+	lockFetchDeleted      sync.RWMutex
+	lockFetchFlowMetrics  sync.RWMutex
+	lockFetchReport       sync.RWMutex
+	lockFetchTaskRows     sync.RWMutex
+	lockFetchTasks        sync.RWMutex
+	lockFetchTrash        sync.RWMutex
+	lockGetByID           sync.RWMutex
+	lockImport            sync.RWMutex
+	lockImportTasks       sync.RWMutex
+	lockRestore           sync.RWMutex
+	lockStore             sync.RWMutex
+	lockStoreColumn       sync.RWMutex
+	lockStoreFromTemplate sync.RWMutex
+	lockUnarchive         sync.RWMutex
+	lockUpdate            sync.RWMutex
+}
+
+// Archive calls ArchiveFunc.
+func (mock *ProjectUsecaseMock) Archive(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if mock.ArchiveFunc == nil {
+		panic("ProjectUsecaseMock.ArchiveFunc: method is nil but ProjectUsecase.Archive was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockArchive.Lock()
+	mock.calls.Archive = append(mock.calls.Archive, callInfo)
+	mock.lockArchive.Unlock()
+	return mock.ArchiveFunc(ctx, id)
+}
+
+// ArchiveCalls gets all the calls that were made to Archive.
+// Check the length with:
+//     len(mockedProjectUsecase.ArchiveCalls())
+func (mock *ProjectUsecaseMock) ArchiveCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockArchive.RLock()
+	calls = mock.calls.Archive
+	mock.lockArchive.RUnlock()
+	return calls
+}
+
+// Clone calls CloneFunc.
+func (mock *ProjectUsecaseMock) Clone(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error) {
+	if mock.CloneFunc == nil {
+		panic("ProjectUsecaseMock.CloneFunc: method is nil but ProjectUsecase.Clone was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Opts domain.CloneOptions
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Opts: opts,
+	}
+	mock.lockClone.Lock()
+	mock.calls.Clone = append(mock.calls.Clone, callInfo)
+	mock.lockClone.Unlock()
+	return mock.CloneFunc(ctx, id, opts)
+}
+
+// CloneCalls gets all the calls that were made to Clone.
+// Check the length with:
+//     len(mockedProjectUsecase.CloneCalls())
+func (mock *ProjectUsecaseMock) CloneCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Opts domain.CloneOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Opts domain.CloneOptions
+	}
+	mock.lockClone.RLock()
+	calls = mock.calls.Clone
+	mock.lockClone.RUnlock()
+	return calls
 }
 
 // Delete calls DeleteFunc.
@@ -177,20 +476,57 @@ func (mock *ProjectUsecaseMock) DeleteCalls() []struct {
 	return calls
 }
 
+// Export calls ExportFunc.
+func (mock *ProjectUsecaseMock) Export(ctx context.Context, id uuid.UUID) (domain.Export, error) {
+	if mock.ExportFunc == nil {
+		panic("ProjectUsecaseMock.ExportFunc: method is nil but ProjectUsecase.Export was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockExport.Lock()
+	mock.calls.Export = append(mock.calls.Export, callInfo)
+	mock.lockExport.Unlock()
+	return mock.ExportFunc(ctx, id)
+}
+
+// ExportCalls gets all the calls that were made to Export.
+// Check the length with:
+//     len(mockedProjectUsecase.ExportCalls())
+func (mock *ProjectUsecaseMock) ExportCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockExport.RLock()
+	calls = mock.calls.Export
+	mock.lockExport.RUnlock()
+	return calls
+}
+
 // Fetch calls FetchFunc.
-func (mock *ProjectUsecaseMock) Fetch(ctx context.Context) ([]domain.Project, error) {
+func (mock *ProjectUsecaseMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 	if mock.FetchFunc == nil {
 		panic("ProjectUsecaseMock.FetchFunc: method is nil but ProjectUsecase.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -198,9 +534,11 @@ func (mock *ProjectUsecaseMock) Fetch(ctx context.Context) ([]domain.Project, er
 //     len(mockedProjectUsecase.FetchCalls())
 func (mock *ProjectUsecaseMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -208,6 +546,84 @@ func (mock *ProjectUsecaseMock) FetchCalls() []struct {
 	return calls
 }
 
+// FetchActivity calls FetchActivityFunc.
+func (mock *ProjectUsecaseMock) FetchActivity(ctx context.Context, id uuid.UUID) (domain.ProjectActivity, error) {
+	if mock.FetchActivityFunc == nil {
+		panic("ProjectUsecaseMock.FetchActivityFunc: method is nil but ProjectUsecase.FetchActivity was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchActivity.Lock()
+	mock.calls.FetchActivity = append(mock.calls.FetchActivity, callInfo)
+	mock.lockFetchActivity.Unlock()
+	return mock.FetchActivityFunc(ctx, id)
+}
+
+// FetchActivityCalls gets all the calls that were made to FetchActivity.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchActivityCalls())
+func (mock *ProjectUsecaseMock) FetchActivityCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchActivity.RLock()
+	calls = mock.calls.FetchActivity
+	mock.lockFetchActivity.RUnlock()
+	return calls
+}
+
+// FetchCFD calls FetchCFDFunc.
+func (mock *ProjectUsecaseMock) FetchCFD(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.CFD, error) {
+	if mock.FetchCFDFunc == nil {
+		panic("ProjectUsecaseMock.FetchCFDFunc: method is nil but ProjectUsecase.FetchCFD was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		From: from,
+		To:   to,
+	}
+	mock.lockFetchCFD.Lock()
+	mock.calls.FetchCFD = append(mock.calls.FetchCFD, callInfo)
+	mock.lockFetchCFD.Unlock()
+	return mock.FetchCFDFunc(ctx, id, from, to)
+}
+
+// FetchCFDCalls gets all the calls that were made to FetchCFD.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchCFDCalls())
+func (mock *ProjectUsecaseMock) FetchCFDCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		From time.Time
+		To   time.Time
+	}
+	mock.lockFetchCFD.RLock()
+	calls = mock.calls.FetchCFD
+	mock.lockFetchCFD.RUnlock()
+	return calls
+}
+
 // FetchColumns calls FetchColumnsFunc.
 func (mock *ProjectUsecaseMock) FetchColumns(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
 	if mock.FetchColumnsFunc == nil {
@@ -243,6 +659,150 @@ func (mock *ProjectUsecaseMock) FetchColumnsCalls() []struct {
 	return calls
 }
 
+// FetchDeleted calls FetchDeletedFunc.
+func (mock *ProjectUsecaseMock) FetchDeleted(ctx context.Context) ([]domain.Project, error) {
+	if mock.FetchDeletedFunc == nil {
+		panic("ProjectUsecaseMock.FetchDeletedFunc: method is nil but ProjectUsecase.FetchDeleted was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFetchDeleted.Lock()
+	mock.calls.FetchDeleted = append(mock.calls.FetchDeleted, callInfo)
+	mock.lockFetchDeleted.Unlock()
+	return mock.FetchDeletedFunc(ctx)
+}
+
+// FetchDeletedCalls gets all the calls that were made to FetchDeleted.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchDeletedCalls())
+func (mock *ProjectUsecaseMock) FetchDeletedCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFetchDeleted.RLock()
+	calls = mock.calls.FetchDeleted
+	mock.lockFetchDeleted.RUnlock()
+	return calls
+}
+
+// FetchFlowMetrics calls FetchFlowMetricsFunc.
+func (mock *ProjectUsecaseMock) FetchFlowMetrics(ctx context.Context, id uuid.UUID, from time.Time, to time.Time) (domain.FlowMetrics, error) {
+	if mock.FetchFlowMetricsFunc == nil {
+		panic("ProjectUsecaseMock.FetchFlowMetricsFunc: method is nil but ProjectUsecase.FetchFlowMetrics was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		From: from,
+		To:   to,
+	}
+	mock.lockFetchFlowMetrics.Lock()
+	mock.calls.FetchFlowMetrics = append(mock.calls.FetchFlowMetrics, callInfo)
+	mock.lockFetchFlowMetrics.Unlock()
+	return mock.FetchFlowMetricsFunc(ctx, id, from, to)
+}
+
+// FetchFlowMetricsCalls gets all the calls that were made to FetchFlowMetrics.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchFlowMetricsCalls())
+func (mock *ProjectUsecaseMock) FetchFlowMetricsCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		From time.Time
+		To   time.Time
+	}
+	mock.lockFetchFlowMetrics.RLock()
+	calls = mock.calls.FetchFlowMetrics
+	mock.lockFetchFlowMetrics.RUnlock()
+	return calls
+}
+
+// FetchReport calls FetchReportFunc.
+func (mock *ProjectUsecaseMock) FetchReport(ctx context.Context, id uuid.UUID) (domain.Report, error) {
+	if mock.FetchReportFunc == nil {
+		panic("ProjectUsecaseMock.FetchReportFunc: method is nil but ProjectUsecase.FetchReport was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchReport.Lock()
+	mock.calls.FetchReport = append(mock.calls.FetchReport, callInfo)
+	mock.lockFetchReport.Unlock()
+	return mock.FetchReportFunc(ctx, id)
+}
+
+// FetchReportCalls gets all the calls that were made to FetchReport.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchReportCalls())
+func (mock *ProjectUsecaseMock) FetchReportCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchReport.RLock()
+	calls = mock.calls.FetchReport
+	mock.lockFetchReport.RUnlock()
+	return calls
+}
+
+// FetchTaskRows calls FetchTaskRowsFunc.
+func (mock *ProjectUsecaseMock) FetchTaskRows(ctx context.Context, id uuid.UUID) ([]domain.TaskRow, error) {
+	if mock.FetchTaskRowsFunc == nil {
+		panic("ProjectUsecaseMock.FetchTaskRowsFunc: method is nil but ProjectUsecase.FetchTaskRows was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchTaskRows.Lock()
+	mock.calls.FetchTaskRows = append(mock.calls.FetchTaskRows, callInfo)
+	mock.lockFetchTaskRows.Unlock()
+	return mock.FetchTaskRowsFunc(ctx, id)
+}
+
+// FetchTaskRowsCalls gets all the calls that were made to FetchTaskRows.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchTaskRowsCalls())
+func (mock *ProjectUsecaseMock) FetchTaskRowsCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchTaskRows.RLock()
+	calls = mock.calls.FetchTaskRows
+	mock.lockFetchTaskRows.RUnlock()
+	return calls
+}
+
 // FetchTasks calls FetchTasksFunc.
 func (mock *ProjectUsecaseMock) FetchTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 	if mock.FetchTasksFunc == nil {
@@ -278,6 +838,41 @@ func (mock *ProjectUsecaseMock) FetchTasksCalls() []struct {
 	return calls
 }
 
+// FetchTrash calls FetchTrashFunc.
+func (mock *ProjectUsecaseMock) FetchTrash(ctx context.Context, id uuid.UUID) (domain.Trash, error) {
+	if mock.FetchTrashFunc == nil {
+		panic("ProjectUsecaseMock.FetchTrashFunc: method is nil but ProjectUsecase.FetchTrash was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchTrash.Lock()
+	mock.calls.FetchTrash = append(mock.calls.FetchTrash, callInfo)
+	mock.lockFetchTrash.Unlock()
+	return mock.FetchTrashFunc(ctx, id)
+}
+
+// FetchTrashCalls gets all the calls that were made to FetchTrash.
+// Check the length with:
+//     len(mockedProjectUsecase.FetchTrashCalls())
+func (mock *ProjectUsecaseMock) FetchTrashCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchTrash.RLock()
+	calls = mock.calls.FetchTrash
+	mock.lockFetchTrash.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *ProjectUsecaseMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
 	if mock.GetByIDFunc == nil {
@@ -313,6 +908,115 @@ func (mock *ProjectUsecaseMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// Import calls ImportFunc.
+func (mock *ProjectUsecaseMock) Import(ctx context.Context, ex *domain.Export) (domain.Project, error) {
+	if mock.ImportFunc == nil {
+		panic("ProjectUsecaseMock.ImportFunc: method is nil but ProjectUsecase.Import was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ex  *domain.Export
+	}{
+		Ctx: ctx,
+		Ex:  ex,
+	}
+	mock.lockImport.Lock()
+	mock.calls.Import = append(mock.calls.Import, callInfo)
+	mock.lockImport.Unlock()
+	return mock.ImportFunc(ctx, ex)
+}
+
+// ImportCalls gets all the calls that were made to Import.
+// Check the length with:
+//     len(mockedProjectUsecase.ImportCalls())
+func (mock *ProjectUsecaseMock) ImportCalls() []struct {
+	Ctx context.Context
+	Ex  *domain.Export
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ex  *domain.Export
+	}
+	mock.lockImport.RLock()
+	calls = mock.calls.Import
+	mock.lockImport.RUnlock()
+	return calls
+}
+
+// ImportTasks calls ImportTasksFunc.
+func (mock *ProjectUsecaseMock) ImportTasks(ctx context.Context, id uuid.UUID, rows []domain.TaskRow) (domain.TaskImport, error) {
+	if mock.ImportTasksFunc == nil {
+		panic("ProjectUsecaseMock.ImportTasksFunc: method is nil but ProjectUsecase.ImportTasks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Rows []domain.TaskRow
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Rows: rows,
+	}
+	mock.lockImportTasks.Lock()
+	mock.calls.ImportTasks = append(mock.calls.ImportTasks, callInfo)
+	mock.lockImportTasks.Unlock()
+	return mock.ImportTasksFunc(ctx, id, rows)
+}
+
+// ImportTasksCalls gets all the calls that were made to ImportTasks.
+// Check the length with:
+//     len(mockedProjectUsecase.ImportTasksCalls())
+func (mock *ProjectUsecaseMock) ImportTasksCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Rows []domain.TaskRow
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Rows []domain.TaskRow
+	}
+	mock.lockImportTasks.RLock()
+	calls = mock.calls.ImportTasks
+	mock.lockImportTasks.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *ProjectUsecaseMock) Restore(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if mock.RestoreFunc == nil {
+		panic("ProjectUsecaseMock.RestoreFunc: method is nil but ProjectUsecase.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, id)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedProjectUsecase.RestoreCalls())
+func (mock *ProjectUsecaseMock) RestoreCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
 func (mock *ProjectUsecaseMock) Store(in1 context.Context, in2 *domain.Project) error {
 	if mock.StoreFunc == nil {
@@ -354,32 +1058,106 @@ func (mock *ProjectUsecaseMock) StoreColumn(in1 context.Context, in2 *domain.Col
 		panic("ProjectUsecaseMock.StoreColumnFunc: method is nil but ProjectUsecase.StoreColumn was just called")
 	}
 	callInfo := struct {
-		In1 context.Context
-		In2 *domain.Column
+		In1 context.Context
+		In2 *domain.Column
+	}{
+		In1: in1,
+		In2: in2,
+	}
+	mock.lockStoreColumn.Lock()
+	mock.calls.StoreColumn = append(mock.calls.StoreColumn, callInfo)
+	mock.lockStoreColumn.Unlock()
+	return mock.StoreColumnFunc(in1, in2)
+}
+
+// StoreColumnCalls gets all the calls that were made to StoreColumn.
+// Check the length with:
+//     len(mockedProjectUsecase.StoreColumnCalls())
+func (mock *ProjectUsecaseMock) StoreColumnCalls() []struct {
+	In1 context.Context
+	In2 *domain.Column
+} {
+	var calls []struct {
+		In1 context.Context
+		In2 *domain.Column
+	}
+	mock.lockStoreColumn.RLock()
+	calls = mock.calls.StoreColumn
+	mock.lockStoreColumn.RUnlock()
+	return calls
+}
+
+// StoreFromTemplate calls StoreFromTemplateFunc.
+func (mock *ProjectUsecaseMock) StoreFromTemplate(ctx context.Context, pr *domain.Project, templateID uuid.UUID) error {
+	if mock.StoreFromTemplateFunc == nil {
+		panic("ProjectUsecaseMock.StoreFromTemplateFunc: method is nil but ProjectUsecase.StoreFromTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Pr         *domain.Project
+		TemplateID uuid.UUID
+	}{
+		Ctx:        ctx,
+		Pr:         pr,
+		TemplateID: templateID,
+	}
+	mock.lockStoreFromTemplate.Lock()
+	mock.calls.StoreFromTemplate = append(mock.calls.StoreFromTemplate, callInfo)
+	mock.lockStoreFromTemplate.Unlock()
+	return mock.StoreFromTemplateFunc(ctx, pr, templateID)
+}
+
+// StoreFromTemplateCalls gets all the calls that were made to StoreFromTemplate.
+// Check the length with:
+//     len(mockedProjectUsecase.StoreFromTemplateCalls())
+func (mock *ProjectUsecaseMock) StoreFromTemplateCalls() []struct {
+	Ctx        context.Context
+	Pr         *domain.Project
+	TemplateID uuid.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Pr         *domain.Project
+		TemplateID uuid.UUID
+	}
+	mock.lockStoreFromTemplate.RLock()
+	calls = mock.calls.StoreFromTemplate
+	mock.lockStoreFromTemplate.RUnlock()
+	return calls
+}
+
+// Unarchive calls UnarchiveFunc.
+func (mock *ProjectUsecaseMock) Unarchive(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if mock.UnarchiveFunc == nil {
+		panic("ProjectUsecaseMock.UnarchiveFunc: method is nil but ProjectUsecase.Unarchive was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
 	}{
-		In1: in1,
-		In2: in2,
+		Ctx: ctx,
+		ID:  id,
 	}
-	mock.lockStoreColumn.Lock()
-	mock.calls.StoreColumn = append(mock.calls.StoreColumn, callInfo)
-	mock.lockStoreColumn.Unlock()
-	return mock.StoreColumnFunc(in1, in2)
+	mock.lockUnarchive.Lock()
+	mock.calls.Unarchive = append(mock.calls.Unarchive, callInfo)
+	mock.lockUnarchive.Unlock()
+	return mock.UnarchiveFunc(ctx, id)
 }
 
-// StoreColumnCalls gets all the calls that were made to StoreColumn.
+// UnarchiveCalls gets all the calls that were made to Unarchive.
 // Check the length with:
-//     len(mockedProjectUsecase.StoreColumnCalls())
-func (mock *ProjectUsecaseMock) StoreColumnCalls() []struct {
-	In1 context.Context
-	In2 *domain.Column
+//     len(mockedProjectUsecase.UnarchiveCalls())
+func (mock *ProjectUsecaseMock) UnarchiveCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
 } {
 	var calls []struct {
-		In1 context.Context
-		In2 *domain.Column
+		Ctx context.Context
+		ID  uuid.UUID
 	}
-	mock.lockStoreColumn.RLock()
-	calls = mock.calls.StoreColumn
-	mock.lockStoreColumn.RUnlock()
+	mock.lockUnarchive.RLock()
+	calls = mock.calls.Unarchive
+	mock.lockUnarchive.RUnlock()
 	return calls
 }
 
@@ -428,18 +1206,45 @@ var _ domain.ProjectRepository = &ProjectRepositoryMock{}
 //
 //         // make and configure a mocked domain.ProjectRepository
 //         mockedProjectRepository := &ProjectRepositoryMock{
+//             CloneFunc: func(ctx context.Context, id uuid.UUID, pr *domain.Project, opts domain.CloneOptions) error {
+// 	               panic("mock out the Clone method")
+//             },
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Project, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 // 	               panic("mock out the Fetch method")
 //             },
+//             FetchDeletedFunc: func(ctx context.Context) ([]domain.Project, error) {
+// 	               panic("mock out the FetchDeleted method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
 // 	               panic("mock out the GetByID method")
 //             },
+//             GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+// 	               panic("mock out the GetByTaskID method")
+//             },
+//             GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+// 	               panic("mock out the GetDeletedByID method")
+//             },
+//             ImportFunc: func(ctx context.Context, pr *domain.Project, ex *domain.Export) error {
+// 	               panic("mock out the Import method")
+//             },
+//             PurgeFunc: func(ctx context.Context, before time.Time) (int64, error) {
+// 	               panic("mock out the Purge method")
+//             },
+//             RestoreFunc: func(ctx context.Context, id uuid.UUID) error {
+// 	               panic("mock out the Restore method")
+//             },
+//             SetArchivedAtFunc: func(ctx context.Context, pr *domain.Project) error {
+// 	               panic("mock out the SetArchivedAt method")
+//             },
 //             StoreFunc: func(ctx context.Context, a *domain.Project) error {
 // 	               panic("mock out the Store method")
 //             },
+//             StoreWithColumnsFunc: func(ctx context.Context, pr *domain.Project, cls []domain.Column) error {
+// 	               panic("mock out the StoreWithColumns method")
+//             },
 //             UpdateFunc: func(ctx context.Context, pr *domain.Project) error {
 // 	               panic("mock out the Update method")
 //             },
@@ -450,23 +1255,61 @@ var _ domain.ProjectRepository = &ProjectRepositoryMock{}
 //
 //     }
 type ProjectRepositoryMock struct {
+	// CloneFunc mocks the Clone method.
+	CloneFunc func(ctx context.Context, id uuid.UUID, pr *domain.Project, opts domain.CloneOptions) error
+
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Project, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Project, error)
+
+	// FetchDeletedFunc mocks the FetchDeleted method.
+	FetchDeletedFunc func(ctx context.Context) ([]domain.Project, error)
 
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
 
+	// GetByTaskIDFunc mocks the GetByTaskID method.
+	GetByTaskIDFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
+
+	// GetDeletedByIDFunc mocks the GetDeletedByID method.
+	GetDeletedByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Project, error)
+
+	// ImportFunc mocks the Import method.
+	ImportFunc func(ctx context.Context, pr *domain.Project, ex *domain.Export) error
+
+	// PurgeFunc mocks the Purge method.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) error
+
+	// SetArchivedAtFunc mocks the SetArchivedAt method.
+	SetArchivedAtFunc func(ctx context.Context, pr *domain.Project) error
+
 	// StoreFunc mocks the Store method.
 	StoreFunc func(ctx context.Context, a *domain.Project) error
 
+	// StoreWithColumnsFunc mocks the StoreWithColumns method.
+	StoreWithColumnsFunc func(ctx context.Context, pr *domain.Project, cls []domain.Column) error
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, pr *domain.Project) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Clone holds details about calls to the Clone method.
+		Clone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Pr is the pr argument value.
+			Pr *domain.Project
+			// Opts is the opts argument value.
+			Opts domain.CloneOptions
+		}
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
 			// Ctx is the ctx argument value.
@@ -478,6 +1321,13 @@ type ProjectRepositoryMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
+		}
+		// FetchDeleted holds details about calls to the FetchDeleted method.
+		FetchDeleted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
@@ -486,6 +1336,50 @@ type ProjectRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// GetByTaskID holds details about calls to the GetByTaskID method.
+		GetByTaskID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetDeletedByID holds details about calls to the GetDeletedByID method.
+		GetDeletedByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Import holds details about calls to the Import method.
+		Import []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pr is the pr argument value.
+			Pr *domain.Project
+			// Ex is the ex argument value.
+			Ex *domain.Export
+		}
+		// Purge holds details about calls to the Purge method.
+		Purge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// SetArchivedAt holds details about calls to the SetArchivedAt method.
+		SetArchivedAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pr is the pr argument value.
+			Pr *domain.Project
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// Ctx is the ctx argument value.
@@ -493,6 +1387,15 @@ type ProjectRepositoryMock struct {
 			// A is the a argument value.
 			A *domain.Project
 		}
+		// StoreWithColumns holds details about calls to the StoreWithColumns method.
+		StoreWithColumns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pr is the pr argument value.
+			Pr *domain.Project
+			// Cls is the cls argument value.
+			Cls []domain.Column
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -501,11 +1404,63 @@ type ProjectRepositoryMock struct {
 			Pr *domain.Project
 		}
 	}
-	lockDelete  sync.RWMutex
-	lockFetch   sync.RWMutex
-	lockGetByID sync.RWMutex
-	lockStore   sync.RWMutex
-	lockUpdate  sync.RWMutex
+	lockClone            sync.RWMutex
+	lockDelete           sync.RWMutex
+	lockFetch            sync.RWMutex
+	lockFetchDeleted     sync.RWMutex
+	lockGetByID          sync.RWMutex
+	lockGetByTaskID      sync.RWMutex
+	lockGetDeletedByID   sync.RWMutex
+	lockImport           sync.RWMutex
+	lockPurge            sync.RWMutex
+	lockRestore          sync.RWMutex
+	lockSetArchivedAt    sync.RWMutex
+	lockStore            sync.RWMutex
+	lockStoreWithColumns sync.RWMutex
+	lockUpdate           sync.RWMutex
+}
+
+// Clone calls CloneFunc.
+func (mock *ProjectRepositoryMock) Clone(ctx context.Context, id uuid.UUID, pr *domain.Project, opts domain.CloneOptions) error {
+	if mock.CloneFunc == nil {
+		panic("ProjectRepositoryMock.CloneFunc: method is nil but ProjectRepository.Clone was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Pr   *domain.Project
+		Opts domain.CloneOptions
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Pr:   pr,
+		Opts: opts,
+	}
+	mock.lockClone.Lock()
+	mock.calls.Clone = append(mock.calls.Clone, callInfo)
+	mock.lockClone.Unlock()
+	return mock.CloneFunc(ctx, id, pr, opts)
+}
+
+// CloneCalls gets all the calls that were made to Clone.
+// Check the length with:
+//     len(mockedProjectRepository.CloneCalls())
+func (mock *ProjectRepositoryMock) CloneCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Pr   *domain.Project
+	Opts domain.CloneOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Pr   *domain.Project
+		Opts domain.CloneOptions
+	}
+	mock.lockClone.RLock()
+	calls = mock.calls.Clone
+	mock.lockClone.RUnlock()
+	return calls
 }
 
 // Delete calls DeleteFunc.
@@ -544,19 +1499,21 @@ func (mock *ProjectRepositoryMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *ProjectRepositoryMock) Fetch(ctx context.Context) ([]domain.Project, error) {
+func (mock *ProjectRepositoryMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 	if mock.FetchFunc == nil {
 		panic("ProjectRepositoryMock.FetchFunc: method is nil but ProjectRepository.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -564,9 +1521,11 @@ func (mock *ProjectRepositoryMock) Fetch(ctx context.Context) ([]domain.Project,
 //     len(mockedProjectRepository.FetchCalls())
 func (mock *ProjectRepositoryMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -574,6 +1533,37 @@ func (mock *ProjectRepositoryMock) FetchCalls() []struct {
 	return calls
 }
 
+// FetchDeleted calls FetchDeletedFunc.
+func (mock *ProjectRepositoryMock) FetchDeleted(ctx context.Context) ([]domain.Project, error) {
+	if mock.FetchDeletedFunc == nil {
+		panic("ProjectRepositoryMock.FetchDeletedFunc: method is nil but ProjectRepository.FetchDeleted was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFetchDeleted.Lock()
+	mock.calls.FetchDeleted = append(mock.calls.FetchDeleted, callInfo)
+	mock.lockFetchDeleted.Unlock()
+	return mock.FetchDeletedFunc(ctx)
+}
+
+// FetchDeletedCalls gets all the calls that were made to FetchDeleted.
+// Check the length with:
+//     len(mockedProjectRepository.FetchDeletedCalls())
+func (mock *ProjectRepositoryMock) FetchDeletedCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFetchDeleted.RLock()
+	calls = mock.calls.FetchDeleted
+	mock.lockFetchDeleted.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *ProjectRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
 	if mock.GetByIDFunc == nil {
@@ -609,6 +1599,220 @@ func (mock *ProjectRepositoryMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// GetByTaskID calls GetByTaskIDFunc.
+func (mock *ProjectRepositoryMock) GetByTaskID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if mock.GetByTaskIDFunc == nil {
+		panic("ProjectRepositoryMock.GetByTaskIDFunc: method is nil but ProjectRepository.GetByTaskID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByTaskID.Lock()
+	mock.calls.GetByTaskID = append(mock.calls.GetByTaskID, callInfo)
+	mock.lockGetByTaskID.Unlock()
+	return mock.GetByTaskIDFunc(ctx, id)
+}
+
+// GetByTaskIDCalls gets all the calls that were made to GetByTaskID.
+// Check the length with:
+//     len(mockedProjectRepository.GetByTaskIDCalls())
+func (mock *ProjectRepositoryMock) GetByTaskIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByTaskID.RLock()
+	calls = mock.calls.GetByTaskID
+	mock.lockGetByTaskID.RUnlock()
+	return calls
+}
+
+// GetDeletedByID calls GetDeletedByIDFunc.
+func (mock *ProjectRepositoryMock) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if mock.GetDeletedByIDFunc == nil {
+		panic("ProjectRepositoryMock.GetDeletedByIDFunc: method is nil but ProjectRepository.GetDeletedByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDeletedByID.Lock()
+	mock.calls.GetDeletedByID = append(mock.calls.GetDeletedByID, callInfo)
+	mock.lockGetDeletedByID.Unlock()
+	return mock.GetDeletedByIDFunc(ctx, id)
+}
+
+// GetDeletedByIDCalls gets all the calls that were made to GetDeletedByID.
+// Check the length with:
+//     len(mockedProjectRepository.GetDeletedByIDCalls())
+func (mock *ProjectRepositoryMock) GetDeletedByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetDeletedByID.RLock()
+	calls = mock.calls.GetDeletedByID
+	mock.lockGetDeletedByID.RUnlock()
+	return calls
+}
+
+// Import calls ImportFunc.
+func (mock *ProjectRepositoryMock) Import(ctx context.Context, pr *domain.Project, ex *domain.Export) error {
+	if mock.ImportFunc == nil {
+		panic("ProjectRepositoryMock.ImportFunc: method is nil but ProjectRepository.Import was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Pr  *domain.Project
+		Ex  *domain.Export
+	}{
+		Ctx: ctx,
+		Pr:  pr,
+		Ex:  ex,
+	}
+	mock.lockImport.Lock()
+	mock.calls.Import = append(mock.calls.Import, callInfo)
+	mock.lockImport.Unlock()
+	return mock.ImportFunc(ctx, pr, ex)
+}
+
+// ImportCalls gets all the calls that were made to Import.
+// Check the length with:
+//     len(mockedProjectRepository.ImportCalls())
+func (mock *ProjectRepositoryMock) ImportCalls() []struct {
+	Ctx context.Context
+	Pr  *domain.Project
+	Ex  *domain.Export
+} {
+	var calls []struct {
+		Ctx context.Context
+		Pr  *domain.Project
+		Ex  *domain.Export
+	}
+	mock.lockImport.RLock()
+	calls = mock.calls.Import
+	mock.lockImport.RUnlock()
+	return calls
+}
+
+// Purge calls PurgeFunc.
+func (mock *ProjectRepositoryMock) Purge(ctx context.Context, before time.Time) (int64, error) {
+	if mock.PurgeFunc == nil {
+		panic("ProjectRepositoryMock.PurgeFunc: method is nil but ProjectRepository.Purge was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockPurge.Lock()
+	mock.calls.Purge = append(mock.calls.Purge, callInfo)
+	mock.lockPurge.Unlock()
+	return mock.PurgeFunc(ctx, before)
+}
+
+// PurgeCalls gets all the calls that were made to Purge.
+// Check the length with:
+//     len(mockedProjectRepository.PurgeCalls())
+func (mock *ProjectRepositoryMock) PurgeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockPurge.RLock()
+	calls = mock.calls.Purge
+	mock.lockPurge.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *ProjectRepositoryMock) Restore(ctx context.Context, id uuid.UUID) error {
+	if mock.RestoreFunc == nil {
+		panic("ProjectRepositoryMock.RestoreFunc: method is nil but ProjectRepository.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, id)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedProjectRepository.RestoreCalls())
+func (mock *ProjectRepositoryMock) RestoreCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
+// SetArchivedAt calls SetArchivedAtFunc.
+func (mock *ProjectRepositoryMock) SetArchivedAt(ctx context.Context, pr *domain.Project) error {
+	if mock.SetArchivedAtFunc == nil {
+		panic("ProjectRepositoryMock.SetArchivedAtFunc: method is nil but ProjectRepository.SetArchivedAt was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Pr  *domain.Project
+	}{
+		Ctx: ctx,
+		Pr:  pr,
+	}
+	mock.lockSetArchivedAt.Lock()
+	mock.calls.SetArchivedAt = append(mock.calls.SetArchivedAt, callInfo)
+	mock.lockSetArchivedAt.Unlock()
+	return mock.SetArchivedAtFunc(ctx, pr)
+}
+
+// SetArchivedAtCalls gets all the calls that were made to SetArchivedAt.
+// Check the length with:
+//     len(mockedProjectRepository.SetArchivedAtCalls())
+func (mock *ProjectRepositoryMock) SetArchivedAtCalls() []struct {
+	Ctx context.Context
+	Pr  *domain.Project
+} {
+	var calls []struct {
+		Ctx context.Context
+		Pr  *domain.Project
+	}
+	mock.lockSetArchivedAt.RLock()
+	calls = mock.calls.SetArchivedAt
+	mock.lockSetArchivedAt.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
 func (mock *ProjectRepositoryMock) Store(ctx context.Context, a *domain.Project) error {
 	if mock.StoreFunc == nil {
@@ -644,6 +1848,45 @@ func (mock *ProjectRepositoryMock) StoreCalls() []struct {
 	return calls
 }
 
+// StoreWithColumns calls StoreWithColumnsFunc.
+func (mock *ProjectRepositoryMock) StoreWithColumns(ctx context.Context, pr *domain.Project, cls []domain.Column) error {
+	if mock.StoreWithColumnsFunc == nil {
+		panic("ProjectRepositoryMock.StoreWithColumnsFunc: method is nil but ProjectRepository.StoreWithColumns was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Pr  *domain.Project
+		Cls []domain.Column
+	}{
+		Ctx: ctx,
+		Pr:  pr,
+		Cls: cls,
+	}
+	mock.lockStoreWithColumns.Lock()
+	mock.calls.StoreWithColumns = append(mock.calls.StoreWithColumns, callInfo)
+	mock.lockStoreWithColumns.Unlock()
+	return mock.StoreWithColumnsFunc(ctx, pr, cls)
+}
+
+// StoreWithColumnsCalls gets all the calls that were made to StoreWithColumns.
+// Check the length with:
+//     len(mockedProjectRepository.StoreWithColumnsCalls())
+func (mock *ProjectRepositoryMock) StoreWithColumnsCalls() []struct {
+	Ctx context.Context
+	Pr  *domain.Project
+	Cls []domain.Column
+} {
+	var calls []struct {
+		Ctx context.Context
+		Pr  *domain.Project
+		Cls []domain.Column
+	}
+	mock.lockStoreWithColumns.RLock()
+	calls = mock.calls.StoreWithColumns
+	mock.lockStoreWithColumns.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *ProjectRepositoryMock) Update(ctx context.Context, pr *domain.Project) error {
 	if mock.UpdateFunc == nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that TaskUsecaseMock does implement domain.TaskUsecase.
@@ -26,12 +27,24 @@ var _ domain.TaskUsecase = &TaskUsecaseMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Task, error) {
+//             DeleteLinkFunc: func(ctx context.Context, taskID uuid.UUID, id uuid.UUID) error {
+// 	               panic("mock out the DeleteLink method")
+//             },
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
 // 	               panic("mock out the Fetch method")
 //             },
+//             FetchByColumnIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+// 	               panic("mock out the FetchByColumnIDs method")
+//             },
+//             FetchChildrenFunc: func(ctx context.Context, id uuid.UUID) (domain.TaskChildren, error) {
+// 	               panic("mock out the FetchChildren method")
+//             },
 //             FetchCommentsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
 // 	               panic("mock out the FetchComments method")
 //             },
+//             FetchLinksFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+// 	               panic("mock out the FetchLinks method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
 // 	               panic("mock out the GetByID method")
 //             },
@@ -41,12 +54,18 @@ var _ domain.TaskUsecase = &TaskUsecaseMock{}
 //             MoveRightFunc: func(ctx context.Context, old *domain.Task, tk *domain.Task, tks []domain.Task) error {
 // 	               panic("mock out the MoveRight method")
 //             },
+//             RestoreFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+// 	               panic("mock out the Restore method")
+//             },
 //             StoreFunc: func(in1 context.Context, in2 *domain.Task) error {
 // 	               panic("mock out the Store method")
 //             },
 //             StoreCommentFunc: func(ctx context.Context, cm *domain.Comment) error {
 // 	               panic("mock out the StoreComment method")
 //             },
+//             StoreLinkFunc: func(ctx context.Context, l *domain.Link) error {
+// 	               panic("mock out the StoreLink method")
+//             },
 //             UpdateFunc: func(ctx context.Context, tk *domain.Task) error {
 // 	               panic("mock out the Update method")
 //             },
@@ -63,12 +82,24 @@ type TaskUsecaseMock struct {
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
+	// DeleteLinkFunc mocks the DeleteLink method.
+	DeleteLinkFunc func(ctx context.Context, taskID uuid.UUID, id uuid.UUID) error
+
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Task, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Task, error)
+
+	// FetchByColumnIDsFunc mocks the FetchByColumnIDs method.
+	FetchByColumnIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error)
+
+	// FetchChildrenFunc mocks the FetchChildren method.
+	FetchChildrenFunc func(ctx context.Context, id uuid.UUID) (domain.TaskChildren, error)
 
 	// FetchCommentsFunc mocks the FetchComments method.
 	FetchCommentsFunc func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error)
 
+	// FetchLinksFunc mocks the FetchLinks method.
+	FetchLinksFunc func(ctx context.Context, id uuid.UUID) ([]domain.Link, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Task, error)
 
@@ -78,12 +109,18 @@ type TaskUsecaseMock struct {
 	// MoveRightFunc mocks the MoveRight method.
 	MoveRightFunc func(ctx context.Context, old *domain.Task, tk *domain.Task, tks []domain.Task) error
 
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, id uuid.UUID) (domain.Task, error)
+
 	// StoreFunc mocks the Store method.
 	StoreFunc func(in1 context.Context, in2 *domain.Task) error
 
 	// StoreCommentFunc mocks the StoreComment method.
 	StoreCommentFunc func(ctx context.Context, cm *domain.Comment) error
 
+	// StoreLinkFunc mocks the StoreLink method.
+	StoreLinkFunc func(ctx context.Context, l *domain.Link) error
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, tk *domain.Task) error
 
@@ -105,10 +142,35 @@ type TaskUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// DeleteLink holds details about calls to the DeleteLink method.
+		DeleteLink []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TaskID is the taskID argument value.
+			TaskID uuid.UUID
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Fetch holds details about calls to the Fetch method.
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
+		}
+		// FetchByColumnIDs holds details about calls to the FetchByColumnIDs method.
+		FetchByColumnIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
+		// FetchChildren holds details about calls to the FetchChildren method.
+		FetchChildren []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
 		}
 		// FetchComments holds details about calls to the FetchComments method.
 		FetchComments []struct {
@@ -117,6 +179,13 @@ type TaskUsecaseMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchLinks holds details about calls to the FetchLinks method.
+		FetchLinks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -146,6 +215,13 @@ type TaskUsecaseMock struct {
 			// Tks is the tks argument value.
 			Tks []domain.Task
 		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// In1 is the in1 argument value.
@@ -160,6 +236,13 @@ type TaskUsecaseMock struct {
 			// Cm is the cm argument value.
 			Cm *domain.Comment
 		}
+		// StoreLink holds details about calls to the StoreLink method.
+		StoreLink []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// L is the l argument value.
+			L *domain.Link
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -168,16 +251,22 @@ type TaskUsecaseMock struct {
 			Tk *domain.Task
 		}
 	}
-	lockChangeColumn  sync.RWMutex
-	lockDelete        sync.RWMutex
-	lockFetch         sync.RWMutex
-	lockFetchComments sync.RWMutex
-	lockGetByID       sync.RWMutex
-	lockMoveLeft      sync.RWMutex
-	lockMoveRight     sync.RWMutex
-	lockStore         sync.RWMutex
-	lockStoreComment  sync.RWMutex
-	lockUpdate        sync.RWMutex
+	lockChangeColumn     sync.RWMutex
+	lockDelete           sync.RWMutex
+	lockDeleteLink       sync.RWMutex
+	lockFetch            sync.RWMutex
+	lockFetchByColumnIDs sync.RWMutex
+	lockFetchChildren    sync.RWMutex
+	lockFetchComments    sync.RWMutex
+	lockFetchLinks       sync.RWMutex
+	lockGetByID          sync.RWMutex
+	lockMoveLeft         sync.RWMutex
+	lockMoveRight        sync.RWMutex
+	lockRestore          sync.RWMutex
+	lockStore            sync.RWMutex
+	lockStoreComment     sync.RWMutex
+	lockStoreLink        sync.RWMutex
+	lockUpdate           sync.RWMutex
 }
 
 // ChangeColumn calls ChangeColumnFunc.
@@ -254,20 +343,61 @@ func (mock *TaskUsecaseMock) DeleteCalls() []struct {
 	return calls
 }
 
+// DeleteLink calls DeleteLinkFunc.
+func (mock *TaskUsecaseMock) DeleteLink(ctx context.Context, taskID uuid.UUID, id uuid.UUID) error {
+	if mock.DeleteLinkFunc == nil {
+		panic("TaskUsecaseMock.DeleteLinkFunc: method is nil but TaskUsecase.DeleteLink was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TaskID uuid.UUID
+		ID     uuid.UUID
+	}{
+		Ctx:    ctx,
+		TaskID: taskID,
+		ID:     id,
+	}
+	mock.lockDeleteLink.Lock()
+	mock.calls.DeleteLink = append(mock.calls.DeleteLink, callInfo)
+	mock.lockDeleteLink.Unlock()
+	return mock.DeleteLinkFunc(ctx, taskID, id)
+}
+
+// DeleteLinkCalls gets all the calls that were made to DeleteLink.
+// Check the length with:
+//     len(mockedTaskUsecase.DeleteLinkCalls())
+func (mock *TaskUsecaseMock) DeleteLinkCalls() []struct {
+	Ctx    context.Context
+	TaskID uuid.UUID
+	ID     uuid.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TaskID uuid.UUID
+		ID     uuid.UUID
+	}
+	mock.lockDeleteLink.RLock()
+	calls = mock.calls.DeleteLink
+	mock.lockDeleteLink.RUnlock()
+	return calls
+}
+
 // Fetch calls FetchFunc.
-func (mock *TaskUsecaseMock) Fetch(ctx context.Context) ([]domain.Task, error) {
+func (mock *TaskUsecaseMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
 	if mock.FetchFunc == nil {
 		panic("TaskUsecaseMock.FetchFunc: method is nil but TaskUsecase.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -275,9 +405,11 @@ func (mock *TaskUsecaseMock) Fetch(ctx context.Context) ([]domain.Task, error) {
 //     len(mockedTaskUsecase.FetchCalls())
 func (mock *TaskUsecaseMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -285,6 +417,76 @@ func (mock *TaskUsecaseMock) FetchCalls() []struct {
 	return calls
 }
 
+// FetchByColumnIDs calls FetchByColumnIDsFunc.
+func (mock *TaskUsecaseMock) FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	if mock.FetchByColumnIDsFunc == nil {
+		panic("TaskUsecaseMock.FetchByColumnIDsFunc: method is nil but TaskUsecase.FetchByColumnIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFetchByColumnIDs.Lock()
+	mock.calls.FetchByColumnIDs = append(mock.calls.FetchByColumnIDs, callInfo)
+	mock.lockFetchByColumnIDs.Unlock()
+	return mock.FetchByColumnIDsFunc(ctx, ids)
+}
+
+// FetchByColumnIDsCalls gets all the calls that were made to FetchByColumnIDs.
+// Check the length with:
+//     len(mockedTaskUsecase.FetchByColumnIDsCalls())
+func (mock *TaskUsecaseMock) FetchByColumnIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockFetchByColumnIDs.RLock()
+	calls = mock.calls.FetchByColumnIDs
+	mock.lockFetchByColumnIDs.RUnlock()
+	return calls
+}
+
+// FetchChildren calls FetchChildrenFunc.
+func (mock *TaskUsecaseMock) FetchChildren(ctx context.Context, id uuid.UUID) (domain.TaskChildren, error) {
+	if mock.FetchChildrenFunc == nil {
+		panic("TaskUsecaseMock.FetchChildrenFunc: method is nil but TaskUsecase.FetchChildren was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchChildren.Lock()
+	mock.calls.FetchChildren = append(mock.calls.FetchChildren, callInfo)
+	mock.lockFetchChildren.Unlock()
+	return mock.FetchChildrenFunc(ctx, id)
+}
+
+// FetchChildrenCalls gets all the calls that were made to FetchChildren.
+// Check the length with:
+//     len(mockedTaskUsecase.FetchChildrenCalls())
+func (mock *TaskUsecaseMock) FetchChildrenCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchChildren.RLock()
+	calls = mock.calls.FetchChildren
+	mock.lockFetchChildren.RUnlock()
+	return calls
+}
+
 // FetchComments calls FetchCommentsFunc.
 func (mock *TaskUsecaseMock) FetchComments(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
 	if mock.FetchCommentsFunc == nil {
@@ -320,6 +522,41 @@ func (mock *TaskUsecaseMock) FetchCommentsCalls() []struct {
 	return calls
 }
 
+// FetchLinks calls FetchLinksFunc.
+func (mock *TaskUsecaseMock) FetchLinks(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+	if mock.FetchLinksFunc == nil {
+		panic("TaskUsecaseMock.FetchLinksFunc: method is nil but TaskUsecase.FetchLinks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchLinks.Lock()
+	mock.calls.FetchLinks = append(mock.calls.FetchLinks, callInfo)
+	mock.lockFetchLinks.Unlock()
+	return mock.FetchLinksFunc(ctx, id)
+}
+
+// FetchLinksCalls gets all the calls that were made to FetchLinks.
+// Check the length with:
+//     len(mockedTaskUsecase.FetchLinksCalls())
+func (mock *TaskUsecaseMock) FetchLinksCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchLinks.RLock()
+	calls = mock.calls.FetchLinks
+	mock.lockFetchLinks.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *TaskUsecaseMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Task, error) {
 	if mock.GetByIDFunc == nil {
@@ -441,6 +678,41 @@ func (mock *TaskUsecaseMock) MoveRightCalls() []struct {
 	return calls
 }
 
+// Restore calls RestoreFunc.
+func (mock *TaskUsecaseMock) Restore(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+	if mock.RestoreFunc == nil {
+		panic("TaskUsecaseMock.RestoreFunc: method is nil but TaskUsecase.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, id)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedTaskUsecase.RestoreCalls())
+func (mock *TaskUsecaseMock) RestoreCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
 func (mock *TaskUsecaseMock) Store(in1 context.Context, in2 *domain.Task) error {
 	if mock.StoreFunc == nil {
@@ -511,6 +783,41 @@ func (mock *TaskUsecaseMock) StoreCommentCalls() []struct {
 	return calls
 }
 
+// StoreLink calls StoreLinkFunc.
+func (mock *TaskUsecaseMock) StoreLink(ctx context.Context, l *domain.Link) error {
+	if mock.StoreLinkFunc == nil {
+		panic("TaskUsecaseMock.StoreLinkFunc: method is nil but TaskUsecase.StoreLink was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		L   *domain.Link
+	}{
+		Ctx: ctx,
+		L:   l,
+	}
+	mock.lockStoreLink.Lock()
+	mock.calls.StoreLink = append(mock.calls.StoreLink, callInfo)
+	mock.lockStoreLink.Unlock()
+	return mock.StoreLinkFunc(ctx, l)
+}
+
+// StoreLinkCalls gets all the calls that were made to StoreLink.
+// Check the length with:
+//     len(mockedTaskUsecase.StoreLinkCalls())
+func (mock *TaskUsecaseMock) StoreLinkCalls() []struct {
+	Ctx context.Context
+	L   *domain.Link
+} {
+	var calls []struct {
+		Ctx context.Context
+		L   *domain.Link
+	}
+	mock.lockStoreLink.RLock()
+	calls = mock.calls.StoreLink
+	mock.lockStoreLink.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *TaskUsecaseMock) Update(ctx context.Context, tk *domain.Task) error {
 	if mock.UpdateFunc == nil {
@@ -559,19 +866,40 @@ var _ domain.TaskRepository = &TaskRepositoryMock{}
 //             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
 // 	               panic("mock out the Delete method")
 //             },
-//             FetchFunc: func(ctx context.Context) ([]domain.Task, error) {
+//             FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
 // 	               panic("mock out the Fetch method")
 //             },
 //             FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 // 	               panic("mock out the FetchByColumnID method")
 //             },
+//             FetchByColumnIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+// 	               panic("mock out the FetchByColumnIDs method")
+//             },
+//             FetchByParentIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+// 	               panic("mock out the FetchByParentID method")
+//             },
 //             FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 // 	               panic("mock out the FetchByProjectID method")
 //             },
+//             FetchDeletedByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+// 	               panic("mock out the FetchDeletedByProjectID method")
+//             },
 //             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
 // 	               panic("mock out the GetByID method")
 //             },
-//             StoreFunc: func(ctx context.Context, t *domain.Task) error {
+//             GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+// 	               panic("mock out the GetDeletedByID method")
+//             },
+//             MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+// 	               panic("mock out the Move method")
+//             },
+//             PurgeFunc: func(ctx context.Context, before time.Time) (int64, error) {
+// 	               panic("mock out the Purge method")
+//             },
+//             RestoreFunc: func(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+// 	               panic("mock out the Restore method")
+//             },
+//             StoreFunc: func(ctx context.Context, t *domain.Task, rec domain.TaskRecords) error {
 // 	               panic("mock out the Store method")
 //             },
 //             UpdateFunc: func(ctx context.Context, tks ...domain.Task) error {
@@ -588,19 +916,40 @@ type TaskRepositoryMock struct {
 	DeleteFunc func(ctx context.Context, id uuid.UUID) error
 
 	// FetchFunc mocks the Fetch method.
-	FetchFunc func(ctx context.Context) ([]domain.Task, error)
+	FetchFunc func(ctx context.Context, f domain.Filter) ([]domain.Task, error)
 
 	// FetchByColumnIDFunc mocks the FetchByColumnID method.
 	FetchByColumnIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
 
+	// FetchByColumnIDsFunc mocks the FetchByColumnIDs method.
+	FetchByColumnIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error)
+
+	// FetchByParentIDFunc mocks the FetchByParentID method.
+	FetchByParentIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
+
 	// FetchByProjectIDFunc mocks the FetchByProjectID method.
 	FetchByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
 
+	// FetchDeletedByProjectIDFunc mocks the FetchDeletedByProjectID method.
+	FetchDeletedByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Task, error)
 
+	// GetDeletedByIDFunc mocks the GetDeletedByID method.
+	GetDeletedByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Task, error)
+
+	// MoveFunc mocks the Move method.
+	MoveFunc func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error
+
+	// PurgeFunc mocks the Purge method.
+	PurgeFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	// RestoreFunc mocks the Restore method.
+	RestoreFunc func(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error
+
 	// StoreFunc mocks the Store method.
-	StoreFunc func(ctx context.Context, t *domain.Task) error
+	StoreFunc func(ctx context.Context, t *domain.Task, rec domain.TaskRecords) error
 
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, tks ...domain.Task) error
@@ -618,6 +967,8 @@ type TaskRepositoryMock struct {
 		Fetch []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// F is the f argument value.
+			F domain.Filter
 		}
 		// FetchByColumnID holds details about calls to the FetchByColumnID method.
 		FetchByColumnID []struct {
@@ -626,6 +977,20 @@ type TaskRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchByColumnIDs holds details about calls to the FetchByColumnIDs method.
+		FetchByColumnIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
+		// FetchByParentID holds details about calls to the FetchByParentID method.
+		FetchByParentID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// FetchByProjectID holds details about calls to the FetchByProjectID method.
 		FetchByProjectID []struct {
 			// Ctx is the ctx argument value.
@@ -633,6 +998,13 @@ type TaskRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// FetchDeletedByProjectID holds details about calls to the FetchDeletedByProjectID method.
+		FetchDeletedByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -640,12 +1012,46 @@ type TaskRepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// GetDeletedByID holds details about calls to the GetDeletedByID method.
+		GetDeletedByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Move holds details about calls to the Move method.
+		Move []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Recs is the recs argument value.
+			Recs []domain.TaskRecords
+			// Tks is the tks argument value.
+			Tks []domain.Task
+		}
+		// Purge holds details about calls to the Purge method.
+		Purge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Restore holds details about calls to the Restore method.
+		Restore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tk is the tk argument value.
+			Tk *domain.Task
+			// Rec is the rec argument value.
+			Rec domain.TaskRecords
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// T is the t argument value.
 			T *domain.Task
+			// Rec is the rec argument value.
+			Rec domain.TaskRecords
 		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
@@ -655,13 +1061,20 @@ type TaskRepositoryMock struct {
 			Tks []domain.Task
 		}
 	}
-	lockDelete           sync.RWMutex
-	lockFetch            sync.RWMutex
-	lockFetchByColumnID  sync.RWMutex
-	lockFetchByProjectID sync.RWMutex
-	lockGetByID          sync.RWMutex
-	lockStore            sync.RWMutex
-	lockUpdate           sync.RWMutex
+	lockDelete                  sync.RWMutex
+	lockFetch                   sync.RWMutex
+	lockFetchByColumnID         sync.RWMutex
+	lockFetchByColumnIDs        sync.RWMutex
+	lockFetchByParentID         sync.RWMutex
+	lockFetchByProjectID        sync.RWMutex
+	lockFetchDeletedByProjectID sync.RWMutex
+	lockGetByID                 sync.RWMutex
+	lockGetDeletedByID          sync.RWMutex
+	lockMove                    sync.RWMutex
+	lockPurge                   sync.RWMutex
+	lockRestore                 sync.RWMutex
+	lockStore                   sync.RWMutex
+	lockUpdate                  sync.RWMutex
 }
 
 // Delete calls DeleteFunc.
@@ -700,19 +1113,21 @@ func (mock *TaskRepositoryMock) DeleteCalls() []struct {
 }
 
 // Fetch calls FetchFunc.
-func (mock *TaskRepositoryMock) Fetch(ctx context.Context) ([]domain.Task, error) {
+func (mock *TaskRepositoryMock) Fetch(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
 	if mock.FetchFunc == nil {
 		panic("TaskRepositoryMock.FetchFunc: method is nil but TaskRepository.Fetch was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
+		F   domain.Filter
 	}{
 		Ctx: ctx,
+		F:   f,
 	}
 	mock.lockFetch.Lock()
 	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
 	mock.lockFetch.Unlock()
-	return mock.FetchFunc(ctx)
+	return mock.FetchFunc(ctx, f)
 }
 
 // FetchCalls gets all the calls that were made to Fetch.
@@ -720,9 +1135,11 @@ func (mock *TaskRepositoryMock) Fetch(ctx context.Context) ([]domain.Task, error
 //     len(mockedTaskRepository.FetchCalls())
 func (mock *TaskRepositoryMock) FetchCalls() []struct {
 	Ctx context.Context
+	F   domain.Filter
 } {
 	var calls []struct {
 		Ctx context.Context
+		F   domain.Filter
 	}
 	mock.lockFetch.RLock()
 	calls = mock.calls.Fetch
@@ -765,6 +1182,76 @@ func (mock *TaskRepositoryMock) FetchByColumnIDCalls() []struct {
 	return calls
 }
 
+// FetchByColumnIDs calls FetchByColumnIDsFunc.
+func (mock *TaskRepositoryMock) FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	if mock.FetchByColumnIDsFunc == nil {
+		panic("TaskRepositoryMock.FetchByColumnIDsFunc: method is nil but TaskRepository.FetchByColumnIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFetchByColumnIDs.Lock()
+	mock.calls.FetchByColumnIDs = append(mock.calls.FetchByColumnIDs, callInfo)
+	mock.lockFetchByColumnIDs.Unlock()
+	return mock.FetchByColumnIDsFunc(ctx, ids)
+}
+
+// FetchByColumnIDsCalls gets all the calls that were made to FetchByColumnIDs.
+// Check the length with:
+//     len(mockedTaskRepository.FetchByColumnIDsCalls())
+func (mock *TaskRepositoryMock) FetchByColumnIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockFetchByColumnIDs.RLock()
+	calls = mock.calls.FetchByColumnIDs
+	mock.lockFetchByColumnIDs.RUnlock()
+	return calls
+}
+
+// FetchByParentID calls FetchByParentIDFunc.
+func (mock *TaskRepositoryMock) FetchByParentID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	if mock.FetchByParentIDFunc == nil {
+		panic("TaskRepositoryMock.FetchByParentIDFunc: method is nil but TaskRepository.FetchByParentID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchByParentID.Lock()
+	mock.calls.FetchByParentID = append(mock.calls.FetchByParentID, callInfo)
+	mock.lockFetchByParentID.Unlock()
+	return mock.FetchByParentIDFunc(ctx, id)
+}
+
+// FetchByParentIDCalls gets all the calls that were made to FetchByParentID.
+// Check the length with:
+//     len(mockedTaskRepository.FetchByParentIDCalls())
+func (mock *TaskRepositoryMock) FetchByParentIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchByParentID.RLock()
+	calls = mock.calls.FetchByParentID
+	mock.lockFetchByParentID.RUnlock()
+	return calls
+}
+
 // FetchByProjectID calls FetchByProjectIDFunc.
 func (mock *TaskRepositoryMock) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
 	if mock.FetchByProjectIDFunc == nil {
@@ -800,6 +1287,41 @@ func (mock *TaskRepositoryMock) FetchByProjectIDCalls() []struct {
 	return calls
 }
 
+// FetchDeletedByProjectID calls FetchDeletedByProjectIDFunc.
+func (mock *TaskRepositoryMock) FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	if mock.FetchDeletedByProjectIDFunc == nil {
+		panic("TaskRepositoryMock.FetchDeletedByProjectIDFunc: method is nil but TaskRepository.FetchDeletedByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchDeletedByProjectID.Lock()
+	mock.calls.FetchDeletedByProjectID = append(mock.calls.FetchDeletedByProjectID, callInfo)
+	mock.lockFetchDeletedByProjectID.Unlock()
+	return mock.FetchDeletedByProjectIDFunc(ctx, id)
+}
+
+// FetchDeletedByProjectIDCalls gets all the calls that were made to FetchDeletedByProjectID.
+// Check the length with:
+//     len(mockedTaskRepository.FetchDeletedByProjectIDCalls())
+func (mock *TaskRepositoryMock) FetchDeletedByProjectIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchDeletedByProjectID.RLock()
+	calls = mock.calls.FetchDeletedByProjectID
+	mock.lockFetchDeletedByProjectID.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *TaskRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Task, error) {
 	if mock.GetByIDFunc == nil {
@@ -835,22 +1357,172 @@ func (mock *TaskRepositoryMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// GetDeletedByID calls GetDeletedByIDFunc.
+func (mock *TaskRepositoryMock) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+	if mock.GetDeletedByIDFunc == nil {
+		panic("TaskRepositoryMock.GetDeletedByIDFunc: method is nil but TaskRepository.GetDeletedByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDeletedByID.Lock()
+	mock.calls.GetDeletedByID = append(mock.calls.GetDeletedByID, callInfo)
+	mock.lockGetDeletedByID.Unlock()
+	return mock.GetDeletedByIDFunc(ctx, id)
+}
+
+// GetDeletedByIDCalls gets all the calls that were made to GetDeletedByID.
+// Check the length with:
+//     len(mockedTaskRepository.GetDeletedByIDCalls())
+func (mock *TaskRepositoryMock) GetDeletedByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetDeletedByID.RLock()
+	calls = mock.calls.GetDeletedByID
+	mock.lockGetDeletedByID.RUnlock()
+	return calls
+}
+
+// Move calls MoveFunc.
+func (mock *TaskRepositoryMock) Move(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+	if mock.MoveFunc == nil {
+		panic("TaskRepositoryMock.MoveFunc: method is nil but TaskRepository.Move was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Recs []domain.TaskRecords
+		Tks  []domain.Task
+	}{
+		Ctx:  ctx,
+		Recs: recs,
+		Tks:  tks,
+	}
+	mock.lockMove.Lock()
+	mock.calls.Move = append(mock.calls.Move, callInfo)
+	mock.lockMove.Unlock()
+	return mock.MoveFunc(ctx, recs, tks...)
+}
+
+// MoveCalls gets all the calls that were made to Move.
+// Check the length with:
+//     len(mockedTaskRepository.MoveCalls())
+func (mock *TaskRepositoryMock) MoveCalls() []struct {
+	Ctx  context.Context
+	Recs []domain.TaskRecords
+	Tks  []domain.Task
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Recs []domain.TaskRecords
+		Tks  []domain.Task
+	}
+	mock.lockMove.RLock()
+	calls = mock.calls.Move
+	mock.lockMove.RUnlock()
+	return calls
+}
+
+// Purge calls PurgeFunc.
+func (mock *TaskRepositoryMock) Purge(ctx context.Context, before time.Time) (int64, error) {
+	if mock.PurgeFunc == nil {
+		panic("TaskRepositoryMock.PurgeFunc: method is nil but TaskRepository.Purge was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockPurge.Lock()
+	mock.calls.Purge = append(mock.calls.Purge, callInfo)
+	mock.lockPurge.Unlock()
+	return mock.PurgeFunc(ctx, before)
+}
+
+// PurgeCalls gets all the calls that were made to Purge.
+// Check the length with:
+//     len(mockedTaskRepository.PurgeCalls())
+func (mock *TaskRepositoryMock) PurgeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockPurge.RLock()
+	calls = mock.calls.Purge
+	mock.lockPurge.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *TaskRepositoryMock) Restore(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+	if mock.RestoreFunc == nil {
+		panic("TaskRepositoryMock.RestoreFunc: method is nil but TaskRepository.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tk  *domain.Task
+		Rec domain.TaskRecords
+	}{
+		Ctx: ctx,
+		Tk:  tk,
+		Rec: rec,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, tk, rec)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//     len(mockedTaskRepository.RestoreCalls())
+func (mock *TaskRepositoryMock) RestoreCalls() []struct {
+	Ctx context.Context
+	Tk  *domain.Task
+	Rec domain.TaskRecords
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tk  *domain.Task
+		Rec domain.TaskRecords
+	}
+	mock.lockRestore.RLock()
+	calls = mock.calls.Restore
+	mock.lockRestore.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
-func (mock *TaskRepositoryMock) Store(ctx context.Context, t *domain.Task) error {
+func (mock *TaskRepositoryMock) Store(ctx context.Context, t *domain.Task, rec domain.TaskRecords) error {
 	if mock.StoreFunc == nil {
 		panic("TaskRepositoryMock.StoreFunc: method is nil but TaskRepository.Store was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
 		T   *domain.Task
+		Rec domain.TaskRecords
 	}{
 		Ctx: ctx,
 		T:   t,
+		Rec: rec,
 	}
 	mock.lockStore.Lock()
 	mock.calls.Store = append(mock.calls.Store, callInfo)
 	mock.lockStore.Unlock()
-	return mock.StoreFunc(ctx, t)
+	return mock.StoreFunc(ctx, t, rec)
 }
 
 // StoreCalls gets all the calls that were made to Store.
@@ -859,10 +1531,12 @@ func (mock *TaskRepositoryMock) Store(ctx context.Context, t *domain.Task) error
 func (mock *TaskRepositoryMock) StoreCalls() []struct {
 	Ctx context.Context
 	T   *domain.Task
+	Rec domain.TaskRecords
 } {
 	var calls []struct {
 		Ctx context.Context
 		T   *domain.Task
+		Rec domain.TaskRecords
 	}
 	mock.lockStore.RLock()
 	calls = mock.calls.Store
@@ -904,3 +1578,129 @@ func (mock *TaskRepositoryMock) UpdateCalls() []struct {
 	mock.lockUpdate.RUnlock()
 	return calls
 }
+
+// Ensure, that TransitionRepositoryMock does implement domain.TransitionRepository.
+// If this is not the case, regenerate this file with moq.
+var _ domain.TransitionRepository = &TransitionRepositoryMock{}
+
+// TransitionRepositoryMock is a mock implementation of domain.TransitionRepository.
+//
+//     func TestSomethingThatUsesTransitionRepository(t *testing.T) {
+//
+//         // make and configure a mocked domain.TransitionRepository
+//         mockedTransitionRepository := &TransitionRepositoryMock{
+//             FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Transition, error) {
+// 	               panic("mock out the FetchByProjectID method")
+//             },
+//             FetchRecentByProjectIDFunc: func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Transition, error) {
+// 	               panic("mock out the FetchRecentByProjectID method")
+//             },
+//         }
+//
+//         // use mockedTransitionRepository in code that requires domain.TransitionRepository
+//         // and then make assertions.
+//
+//     }
+type TransitionRepositoryMock struct {
+	// FetchByProjectIDFunc mocks the FetchByProjectID method.
+	FetchByProjectIDFunc func(ctx context.Context, id uuid.UUID) ([]domain.Transition, error)
+
+	// FetchRecentByProjectIDFunc mocks the FetchRecentByProjectID method.
+	FetchRecentByProjectIDFunc func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Transition, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FetchByProjectID holds details about calls to the FetchByProjectID method.
+		FetchByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// FetchRecentByProjectID holds details about calls to the FetchRecentByProjectID method.
+		FetchRecentByProjectID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Limit is the limit argument value.
+			Limit int
+		}
+	}
+	lockFetchByProjectID       sync.RWMutex
+	lockFetchRecentByProjectID sync.RWMutex
+}
+
+// FetchByProjectID calls FetchByProjectIDFunc.
+func (mock *TransitionRepositoryMock) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Transition, error) {
+	if mock.FetchByProjectIDFunc == nil {
+		panic("TransitionRepositoryMock.FetchByProjectIDFunc: method is nil but TransitionRepository.FetchByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockFetchByProjectID.Lock()
+	mock.calls.FetchByProjectID = append(mock.calls.FetchByProjectID, callInfo)
+	mock.lockFetchByProjectID.Unlock()
+	return mock.FetchByProjectIDFunc(ctx, id)
+}
+
+// FetchByProjectIDCalls gets all the calls that were made to FetchByProjectID.
+// Check the length with:
+//     len(mockedTransitionRepository.FetchByProjectIDCalls())
+func (mock *TransitionRepositoryMock) FetchByProjectIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockFetchByProjectID.RLock()
+	calls = mock.calls.FetchByProjectID
+	mock.lockFetchByProjectID.RUnlock()
+	return calls
+}
+
+// FetchRecentByProjectID calls FetchRecentByProjectIDFunc.
+func (mock *TransitionRepositoryMock) FetchRecentByProjectID(ctx context.Context, id uuid.UUID, limit int) ([]domain.Transition, error) {
+	if mock.FetchRecentByProjectIDFunc == nil {
+		panic("TransitionRepositoryMock.FetchRecentByProjectIDFunc: method is nil but TransitionRepository.FetchRecentByProjectID was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Limit int
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Limit: limit,
+	}
+	mock.lockFetchRecentByProjectID.Lock()
+	mock.calls.FetchRecentByProjectID = append(mock.calls.FetchRecentByProjectID, callInfo)
+	mock.lockFetchRecentByProjectID.Unlock()
+	return mock.FetchRecentByProjectIDFunc(ctx, id, limit)
+}
+
+// FetchRecentByProjectIDCalls gets all the calls that were made to FetchRecentByProjectID.
+// Check the length with:
+//     len(mockedTransitionRepository.FetchRecentByProjectIDCalls())
+func (mock *TransitionRepositoryMock) FetchRecentByProjectIDCalls() []struct {
+	Ctx   context.Context
+	ID    uuid.UUID
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Limit int
+	}
+	mock.lockFetchRecentByProjectID.RLock()
+	calls = mock.calls.FetchRecentByProjectID
+	mock.lockFetchRecentByProjectID.RUnlock()
+	return calls
+}
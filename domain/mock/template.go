@@ -0,0 +1,533 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	"sync"
+)
+
+// Ensure, that TemplateUsecaseMock does implement domain.TemplateUsecase.
+// If this is not the case, regenerate this file with moq.
+var _ domain.TemplateUsecase = &TemplateUsecaseMock{}
+
+// TemplateUsecaseMock is a mock implementation of domain.TemplateUsecase.
+//
+//     func TestSomethingThatUsesTemplateUsecase(t *testing.T) {
+//
+//         // make and configure a mocked domain.TemplateUsecase
+//         mockedTemplateUsecase := &TemplateUsecaseMock{
+//             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+// 	               panic("mock out the Delete method")
+//             },
+//             FetchFunc: func(ctx context.Context) ([]domain.Template, error) {
+// 	               panic("mock out the Fetch method")
+//             },
+//             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+// 	               panic("mock out the GetByID method")
+//             },
+//             StoreFunc: func(ctx context.Context, tm *domain.Template) error {
+// 	               panic("mock out the Store method")
+//             },
+//             UpdateFunc: func(ctx context.Context, tm *domain.Template) error {
+// 	               panic("mock out the Update method")
+//             },
+//         }
+//
+//         // use mockedTemplateUsecase in code that requires domain.TemplateUsecase
+//         // and then make assertions.
+//
+//     }
+type TemplateUsecaseMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	// FetchFunc mocks the Fetch method.
+	FetchFunc func(ctx context.Context) ([]domain.Template, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Template, error)
+
+	// StoreFunc mocks the Store method.
+	StoreFunc func(ctx context.Context, tm *domain.Template) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tm *domain.Template) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Fetch holds details about calls to the Fetch method.
+		Fetch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tm is the tm argument value.
+			Tm *domain.Template
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tm is the tm argument value.
+			Tm *domain.Template
+		}
+	}
+	lockDelete  sync.RWMutex
+	lockFetch   sync.RWMutex
+	lockGetByID sync.RWMutex
+	lockStore   sync.RWMutex
+	lockUpdate  sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *TemplateUsecaseMock) Delete(ctx context.Context, id uuid.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("TemplateUsecaseMock.DeleteFunc: method is nil but TemplateUsecase.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//     len(mockedTemplateUsecase.DeleteCalls())
+func (mock *TemplateUsecaseMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Fetch calls FetchFunc.
+func (mock *TemplateUsecaseMock) Fetch(ctx context.Context) ([]domain.Template, error) {
+	if mock.FetchFunc == nil {
+		panic("TemplateUsecaseMock.FetchFunc: method is nil but TemplateUsecase.Fetch was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFetch.Lock()
+	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
+	mock.lockFetch.Unlock()
+	return mock.FetchFunc(ctx)
+}
+
+// FetchCalls gets all the calls that were made to Fetch.
+// Check the length with:
+//     len(mockedTemplateUsecase.FetchCalls())
+func (mock *TemplateUsecaseMock) FetchCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFetch.RLock()
+	calls = mock.calls.Fetch
+	mock.lockFetch.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *TemplateUsecaseMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	if mock.GetByIDFunc == nil {
+		panic("TemplateUsecaseMock.GetByIDFunc: method is nil but TemplateUsecase.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//     len(mockedTemplateUsecase.GetByIDCalls())
+func (mock *TemplateUsecaseMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Store calls StoreFunc.
+func (mock *TemplateUsecaseMock) Store(ctx context.Context, tm *domain.Template) error {
+	if mock.StoreFunc == nil {
+		panic("TemplateUsecaseMock.StoreFunc: method is nil but TemplateUsecase.Store was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}{
+		Ctx: ctx,
+		Tm:  tm,
+	}
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(ctx, tm)
+}
+
+// StoreCalls gets all the calls that were made to Store.
+// Check the length with:
+//     len(mockedTemplateUsecase.StoreCalls())
+func (mock *TemplateUsecaseMock) StoreCalls() []struct {
+	Ctx context.Context
+	Tm  *domain.Template
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *TemplateUsecaseMock) Update(ctx context.Context, tm *domain.Template) error {
+	if mock.UpdateFunc == nil {
+		panic("TemplateUsecaseMock.UpdateFunc: method is nil but TemplateUsecase.Update was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}{
+		Ctx: ctx,
+		Tm:  tm,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tm)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//     len(mockedTemplateUsecase.UpdateCalls())
+func (mock *TemplateUsecaseMock) UpdateCalls() []struct {
+	Ctx context.Context
+	Tm  *domain.Template
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
+
+// Ensure, that TemplateRepositoryMock does implement domain.TemplateRepository.
+// If this is not the case, regenerate this file with moq.
+var _ domain.TemplateRepository = &TemplateRepositoryMock{}
+
+// TemplateRepositoryMock is a mock implementation of domain.TemplateRepository.
+//
+//     func TestSomethingThatUsesTemplateRepository(t *testing.T) {
+//
+//         // make and configure a mocked domain.TemplateRepository
+//         mockedTemplateRepository := &TemplateRepositoryMock{
+//             DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+// 	               panic("mock out the Delete method")
+//             },
+//             FetchFunc: func(ctx context.Context) ([]domain.Template, error) {
+// 	               panic("mock out the Fetch method")
+//             },
+//             GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+// 	               panic("mock out the GetByID method")
+//             },
+//             StoreFunc: func(ctx context.Context, tm *domain.Template) error {
+// 	               panic("mock out the Store method")
+//             },
+//             UpdateFunc: func(ctx context.Context, tm *domain.Template) error {
+// 	               panic("mock out the Update method")
+//             },
+//         }
+//
+//         // use mockedTemplateRepository in code that requires domain.TemplateRepository
+//         // and then make assertions.
+//
+//     }
+type TemplateRepositoryMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	// FetchFunc mocks the Fetch method.
+	FetchFunc func(ctx context.Context) ([]domain.Template, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (domain.Template, error)
+
+	// StoreFunc mocks the Store method.
+	StoreFunc func(ctx context.Context, tm *domain.Template) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tm *domain.Template) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Fetch holds details about calls to the Fetch method.
+		Fetch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Store holds details about calls to the Store method.
+		Store []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tm is the tm argument value.
+			Tm *domain.Template
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tm is the tm argument value.
+			Tm *domain.Template
+		}
+	}
+	lockDelete  sync.RWMutex
+	lockFetch   sync.RWMutex
+	lockGetByID sync.RWMutex
+	lockStore   sync.RWMutex
+	lockUpdate  sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *TemplateRepositoryMock) Delete(ctx context.Context, id uuid.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("TemplateRepositoryMock.DeleteFunc: method is nil but TemplateRepository.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//     len(mockedTemplateRepository.DeleteCalls())
+func (mock *TemplateRepositoryMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Fetch calls FetchFunc.
+func (mock *TemplateRepositoryMock) Fetch(ctx context.Context) ([]domain.Template, error) {
+	if mock.FetchFunc == nil {
+		panic("TemplateRepositoryMock.FetchFunc: method is nil but TemplateRepository.Fetch was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFetch.Lock()
+	mock.calls.Fetch = append(mock.calls.Fetch, callInfo)
+	mock.lockFetch.Unlock()
+	return mock.FetchFunc(ctx)
+}
+
+// FetchCalls gets all the calls that were made to Fetch.
+// Check the length with:
+//     len(mockedTemplateRepository.FetchCalls())
+func (mock *TemplateRepositoryMock) FetchCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFetch.RLock()
+	calls = mock.calls.Fetch
+	mock.lockFetch.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *TemplateRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	if mock.GetByIDFunc == nil {
+		panic("TemplateRepositoryMock.GetByIDFunc: method is nil but TemplateRepository.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//     len(mockedTemplateRepository.GetByIDCalls())
+func (mock *TemplateRepositoryMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Store calls StoreFunc.
+func (mock *TemplateRepositoryMock) Store(ctx context.Context, tm *domain.Template) error {
+	if mock.StoreFunc == nil {
+		panic("TemplateRepositoryMock.StoreFunc: method is nil but TemplateRepository.Store was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}{
+		Ctx: ctx,
+		Tm:  tm,
+	}
+	mock.lockStore.Lock()
+	mock.calls.Store = append(mock.calls.Store, callInfo)
+	mock.lockStore.Unlock()
+	return mock.StoreFunc(ctx, tm)
+}
+
+// StoreCalls gets all the calls that were made to Store.
+// Check the length with:
+//     len(mockedTemplateRepository.StoreCalls())
+func (mock *TemplateRepositoryMock) StoreCalls() []struct {
+	Ctx context.Context
+	Tm  *domain.Template
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}
+	mock.lockStore.RLock()
+	calls = mock.calls.Store
+	mock.lockStore.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *TemplateRepositoryMock) Update(ctx context.Context, tm *domain.Template) error {
+	if mock.UpdateFunc == nil {
+		panic("TemplateRepositoryMock.UpdateFunc: method is nil but TemplateRepository.Update was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}{
+		Ctx: ctx,
+		Tm:  tm,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tm)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//     len(mockedTemplateRepository.UpdateCalls())
+func (mock *TemplateRepositoryMock) UpdateCalls() []struct {
+	Ctx context.Context
+	Tm  *domain.Template
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tm  *domain.Template
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,28 +12,110 @@ import (
 
 // Project represent a project in tasktracker.
 type Project struct {
-	ID          uuid.UUID `json:"id,omitempty" readonly:"true"`
-	Name        string    `json:"name" validate:"required,min=1,max=500"`
-	Description string    `json:"description" validate:"required,min=0,max=1000"`
+	ID          uuid.UUID  `json:"id,omitempty" readonly:"true"`
+	Name        string     `json:"name" validate:"required,min=1,max=500"`
+	Description string     `json:"description" validate:"required,min=0,max=1000"`
+	CreatedAt   time.Time  `json:"created_at" readonly:"true"`
+	UpdatedAt   time.Time  `json:"updated_at" readonly:"true"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty" readonly:"true"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" readonly:"true"`
+	// Labels are the labels of the board, they come from the template the project is made from.
+	Labels []Label `json:"labels" readonly:"true"`
+}
+
+// Label represent a named color used to mark the tasks of a board.
+type Label struct {
+	Name  string `json:"name" validate:"required,min=1,max=255"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+// IsArchived reports whether the board of the project is read-only.
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// CheckArchived verifies that the board of the found project can be changed,
+// it takes the results of the project lookups, e.g. CheckArchived(repo.GetByTaskID(ctx, id)).
+func CheckArchived(project Project, err error) error {
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+	if project.IsArchived() {
+		return ErrArchived
+	}
+
+	return nil
+}
+
+// CheckLabels verifies that the names of the labels of a board are unique.
+func CheckLabels(labels []Label) error {
+	names := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		if names[l.Name] {
+			return fmt.Errorf("label name: %w", ErrUnique)
+		}
+		names[l.Name] = true
+	}
+
+	return nil
+}
+
+// Trash represent the columns and tasks deleted from a project one by one.
+type Trash struct {
+	Columns []Column `json:"columns"`
+	Tasks   []Task   `json:"tasks"`
+}
+
+// CloneOptions represent what is copied to the new project when a project is cloned.
+// The columns are always copied, the comments are copied together with the tasks only.
+type CloneOptions struct {
+	Name        string `json:"name" validate:"max=500"`
+	Description string `json:"description" validate:"max=1000"`
+	Tasks       bool   `json:"tasks"`
+	Comments    bool   `json:"comments"`
 }
 
 // ProjectUsecase represent the project's usecases.
 type ProjectUsecase interface {
-	Fetch(ctx context.Context) ([]Project, error)
+	Fetch(ctx context.Context, f Filter) ([]Project, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Project, error)
 	Update(ctx context.Context, pr *Project) error
 	Store(context.Context, *Project) error
+	StoreFromTemplate(ctx context.Context, pr *Project, templateID uuid.UUID) error
+	Clone(ctx context.Context, id uuid.UUID, opts CloneOptions) (Project, error)
+	Export(ctx context.Context, id uuid.UUID) (Export, error)
+	Import(ctx context.Context, ex *Export) (Project, error)
+	FetchTaskRows(ctx context.Context, id uuid.UUID) ([]TaskRow, error)
+	ImportTasks(ctx context.Context, id uuid.UUID, rows []TaskRow) (TaskImport, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	FetchColumns(ctx context.Context, id uuid.UUID) ([]Column, error)
 	StoreColumn(context.Context, *Column) error
 	FetchTasks(ctx context.Context, id uuid.UUID) ([]Task, error)
+	FetchFlowMetrics(ctx context.Context, id uuid.UUID, from, to time.Time) (FlowMetrics, error)
+	FetchCFD(ctx context.Context, id uuid.UUID, from, to time.Time) (CFD, error)
+	FetchReport(ctx context.Context, id uuid.UUID) (Report, error)
+	FetchActivity(ctx context.Context, id uuid.UUID) (ProjectActivity, error)
+	FetchDeleted(ctx context.Context) ([]Project, error)
+	FetchTrash(ctx context.Context, id uuid.UUID) (Trash, error)
+	Restore(ctx context.Context, id uuid.UUID) (Project, error)
+	Archive(ctx context.Context, id uuid.UUID) (Project, error)
+	Unarchive(ctx context.Context, id uuid.UUID) (Project, error)
 }
 
 // ProjectRepository represent the project's repository contract.
 type ProjectRepository interface {
-	Fetch(ctx context.Context) ([]Project, error)
+	Fetch(ctx context.Context, f Filter) ([]Project, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Project, error)
+	GetByTaskID(ctx context.Context, id uuid.UUID) (Project, error)
 	Update(ctx context.Context, pr *Project) error
+	SetArchivedAt(ctx context.Context, pr *Project) error
 	Store(ctx context.Context, a *Project) error
+	StoreWithColumns(ctx context.Context, pr *Project, cls []Column) error
+	Clone(ctx context.Context, id uuid.UUID, pr *Project, opts CloneOptions) error
+	Import(ctx context.Context, pr *Project, ex *Export) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	FetchDeleted(ctx context.Context) ([]Project, error)
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (Project, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, before time.Time) (int64, error)
 }
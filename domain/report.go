@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Report represent the board of a project prepared for rendering.
+type Report struct {
+	Project     Project        `json:"project"`
+	Columns     []ReportColumn `json:"columns"`
+	GeneratedAt time.Time      `json:"generated_at"`
+}
+
+// ReportColumn represent a column of a report with its tasks in board order.
+type ReportColumn struct {
+	Column Column       `json:"column"`
+	Tasks  []ReportTask `json:"tasks"`
+}
+
+// ReportTask represent a task of a report with its most recent comments, the newest first.
+type ReportTask struct {
+	Task     Task      `json:"task"`
+	Comments []Comment `json:"comments"`
+}
@@ -2,24 +2,101 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-//go:generate moq -out ./mock/task.go -pkg mocks . TaskUsecase TaskRepository
+//go:generate moq -out ./mock/task.go -pkg mocks . TaskUsecase TaskRepository TransitionRepository
 
 // Task represent a task in tasktracker.
 type Task struct {
-	ID          uuid.UUID `json:"id" readonly:"true"`
-	Position    int       `json:"position" validate:"min=0"`
-	Name        string    `json:"name" validate:"required,min=1,max=500"`
-	Description string    `json:"description" validate:"required,min=0,max=5000"`
-	ColumnID    uuid.UUID `json:"column_id" validate:"required"`
+	ID          uuid.UUID  `json:"id" readonly:"true"`
+	Position    int        `json:"position" validate:"min=0"`
+	Name        string     `json:"name" validate:"required,min=1,max=500"`
+	Description string     `json:"description" validate:"required,min=0,max=5000"`
+	ColumnID    uuid.UUID  `json:"column_id" validate:"required"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" readonly:"true"`
+	CreatedAt   time.Time  `json:"created_at" readonly:"true"`
+	UpdatedAt   time.Time  `json:"updated_at" readonly:"true"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" readonly:"true"`
+}
+
+// SetCompletedAt marks the task completed when it enters a done column and clears the mark when it leaves.
+func (t *Task) SetCompletedAt(column *Column) {
+	if column.Category != CategoryDone {
+		t.CompletedAt = nil
+
+		return
+	}
+	if t.CompletedAt == nil {
+		now := time.Now().UTC()
+		t.CompletedAt = &now
+	}
+}
+
+// Transition represent a move of a task into a column.
+// FromColumnID is nil when the task was created in the column.
+type Transition struct {
+	ID           uuid.UUID  `json:"id"`
+	TaskID       uuid.UUID  `json:"task_id"`
+	FromColumnID *uuid.UUID `json:"from_column_id,omitempty"`
+	ToColumnID   uuid.UUID  `json:"to_column_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TaskRecords represent the records of a task change, they are written in the same transaction
+// as the change. The nil records are not written.
+type TaskRecords struct {
+	Transition  *Transition
+	WIPOverride *WIPOverride
+}
+
+// NewTaskRecords returns the records of the task entering the column with count tasks, from is nil
+// when the task is created. The WIP override is recorded only when the limit was overridden.
+func NewTaskRecords(taskID uuid.UUID, from *uuid.UUID, column *Column, count int, overridden bool) TaskRecords {
+	now := time.Now().UTC()
+	rec := TaskRecords{
+		Transition: &Transition{
+			ID:           uuid.Nil,
+			TaskID:       taskID,
+			FromColumnID: from,
+			ToColumnID:   column.ID,
+			CreatedAt:    now,
+		},
+		WIPOverride: nil,
+	}
+	if overridden {
+		rec.WIPOverride = &WIPOverride{
+			ID:        uuid.Nil,
+			TaskID:    taskID,
+			ColumnID:  column.ID,
+			WIPLimit:  *column.WIPLimit,
+			Count:     count,
+			CreatedAt: now,
+		}
+	}
+
+	return rec
+}
+
+// Progress represent the roll-up of child tasks by the status of their columns.
+type Progress struct {
+	Total    int            `json:"total"`
+	Done     int            `json:"done"`
+	ByStatus map[string]int `json:"by_status"`
+}
+
+// TaskChildren represent the child tasks of a parent task with their progress.
+type TaskChildren struct {
+	Children []Task   `json:"children"`
+	Progress Progress `json:"progress"`
 }
 
 // TaskUsecase represent the task's usecases.
 type TaskUsecase interface {
-	Fetch(ctx context.Context) ([]Task, error)
+	Fetch(ctx context.Context, f Filter) ([]Task, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Task, error)
 	Update(ctx context.Context, tk *Task) error
 	ChangeColumn(ctx context.Context, old, tk *Task) error
@@ -27,17 +104,36 @@ type TaskUsecase interface {
 	MoveLeft(ctx context.Context, old, tk *Task, tks []Task) error
 	Store(context.Context, *Task) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]Task, error)
 	FetchComments(ctx context.Context, id uuid.UUID) ([]Comment, error)
+	FetchChildren(ctx context.Context, id uuid.UUID) (TaskChildren, error)
 	StoreComment(ctx context.Context, cm *Comment) error
+	FetchLinks(ctx context.Context, id uuid.UUID) ([]Link, error)
+	StoreLink(ctx context.Context, l *Link) error
+	DeleteLink(ctx context.Context, taskID, id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) (Task, error)
 }
 
 // TaskRepository represent the project's repository contract.
 type TaskRepository interface {
-	Fetch(ctx context.Context) ([]Task, error)
+	Fetch(ctx context.Context, f Filter) ([]Task, error)
 	FetchByColumnID(ctx context.Context, id uuid.UUID) ([]Task, error)
+	FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]Task, error)
 	FetchByProjectID(ctx context.Context, id uuid.UUID) ([]Task, error)
+	FetchByParentID(ctx context.Context, id uuid.UUID) ([]Task, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Task, error)
 	Update(ctx context.Context, tks ...Task) error
-	Store(ctx context.Context, t *Task) error
+	Move(ctx context.Context, recs []TaskRecords, tks ...Task) error
+	Store(ctx context.Context, t *Task, rec TaskRecords) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]Task, error)
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (Task, error)
+	Restore(ctx context.Context, tk *Task, rec TaskRecords) error
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// TransitionRepository represent the transition's repository contract.
+type TransitionRepository interface {
+	FetchByProjectID(ctx context.Context, id uuid.UUID) ([]Transition, error)
+	FetchRecentByProjectID(ctx context.Context, id uuid.UUID, limit int) ([]Transition, error)
 }
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate moq -out ./mock/template.go -pkg mocks . TemplateUsecase TemplateRepository
+
+// TemplateColumn represent a column created on the board of a project made from a template.
+type TemplateColumn struct {
+	Name     string   `json:"name" validate:"required,min=1,max=255"`
+	Status   string   `json:"status" validate:"required,min=1,max=255"`
+	Category Category `json:"category" validate:"omitempty,oneof=todo in-progress done"`
+	WIPLimit *int     `json:"wip_limit,omitempty" validate:"omitempty,min=1"`
+}
+
+// Template represent a reusable board of columns and labels for new projects.
+type Template struct {
+	ID          uuid.UUID        `json:"id" readonly:"true"`
+	Name        string           `json:"name" validate:"required,min=1,max=255"`
+	Description string           `json:"description" validate:"min=0,max=1000"`
+	Columns     []TemplateColumn `json:"columns" validate:"required,min=1,dive"`
+	Labels      []Label          `json:"labels" validate:"dive"`
+	CreatedAt   time.Time        `json:"created_at" readonly:"true"`
+	UpdatedAt   time.Time        `json:"updated_at" readonly:"true"`
+}
+
+// TemplateUsecase represent the template's usecases.
+type TemplateUsecase interface {
+	Fetch(ctx context.Context) ([]Template, error)
+	GetByID(ctx context.Context, id uuid.UUID) (Template, error)
+	Update(ctx context.Context, tm *Template) error
+	Store(ctx context.Context, tm *Template) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// TemplateRepository represent the template's repository contract.
+type TemplateRepository interface {
+	Fetch(ctx context.Context) ([]Template, error)
+	GetByID(ctx context.Context, id uuid.UUID) (Template, error)
+	Update(ctx context.Context, tm *Template) error
+	Store(ctx context.Context, tm *Template) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,103 @@
+// Package atom writes Atom syndication feeds (RFC 4287).
+package atom
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentType is the media type of an Atom feed.
+const ContentType = "application/atom+xml; charset=utf-8"
+
+const namespace = "http://www.w3.org/2005/Atom"
+
+// Feed represent an Atom feed.
+type Feed struct {
+	XMLName xml.Name `xml:"feed"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated Time     `xml:"updated"`
+	Author  Person   `xml:"author"`
+	Links   []Link   `xml:"link"`
+	Entries []Entry  `xml:"entry"`
+}
+
+// Entry represent an entry of an Atom feed.
+type Entry struct {
+	ID        string    `xml:"id"`
+	Title     string    `xml:"title"`
+	Published Time      `xml:"published"`
+	Updated   Time      `xml:"updated"`
+	Links     []Link    `xml:"link"`
+	Summary   *Text     `xml:"summary,omitempty"`
+	Category  *Category `xml:"category,omitempty"`
+}
+
+// Person represent the author of a feed.
+type Person struct {
+	Name string `xml:"name"`
+}
+
+// Link represent a link of a feed or an entry.
+type Link struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+// Text represent a text construct of an entry.
+type Text struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+// Category represent the category of an entry.
+type Category struct {
+	Term string `xml:"term,attr"`
+}
+
+// Time is a time written in the RFC 3339 form required by Atom.
+type Time time.Time
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(time.Time(t).UTC().Format(time.RFC3339)), nil
+}
+
+// ID returns the URN used as a stable id of the feed or entry of the item with the uuid.
+func ID(id uuid.UUID) string {
+	return id.URN()
+}
+
+// New will create new a Feed with the Atom namespace.
+func New(id, title string, updated time.Time) Feed {
+	return Feed{
+		XMLName: xml.Name{Space: "", Local: "feed"},
+		Xmlns:   namespace,
+		ID:      id,
+		Title:   title,
+		Updated: Time(updated),
+		Author:  Person{Name: "tasktracker"},
+		Links:   nil,
+		Entries: nil,
+	}
+}
+
+// Write writes the feed as an XML document to w.
+func (f *Feed) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("write xml header: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return fmt.Errorf("encode atom feed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package atom_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/internal/atom"
+	helper "github.com/matryer/is"
+)
+
+func TestWrite(t *testing.T) {
+	is := helper.New(t)
+
+	id := uuid.MustParse("177ef0d8-6630-11ea-b69a-0242ac130003")
+	updated := time.Date(2026, 10, 1, 14, 0, 0, 0, time.FixedZone("EEST", 3*60*60))
+	feed := atom.New(atom.ID(id), "board <1>", updated)
+	feed.Entries = append(feed.Entries, atom.Entry{
+		ID:        atom.ID(id),
+		Title:     "entry",
+		Published: atom.Time(updated),
+		Updated:   atom.Time(updated),
+		Links:     nil,
+		Summary:   nil,
+		Category:  &atom.Category{Term: "task_created"},
+	})
+	var buf bytes.Buffer
+	is.NoErr(feed.Write(&buf))
+	got := buf.String()
+	is.True(strings.HasPrefix(got, `<?xml version="1.0" encoding="UTF-8"?>`))
+	is.True(strings.Contains(got, `<feed xmlns="http://www.w3.org/2005/Atom">`))
+	is.True(strings.Contains(got, "<id>urn:uuid:177ef0d8-6630-11ea-b69a-0242ac130003</id>"))
+	is.True(strings.Contains(got, "<title>board &lt;1&gt;</title>"))
+	is.True(strings.Contains(got, "<updated>2026-10-01T11:00:00Z</updated>"))
+	is.True(strings.Contains(got, `<category term="task_created"></category>`))
+	is.True(!strings.Contains(got, "<summary"))
+}
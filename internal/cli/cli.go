@@ -0,0 +1,426 @@
+// Package cli implements tt, the command line client of the task tracker API for the daily board operations.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/client"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/tui"
+)
+
+// defaultRefresh is the interval of reloading the interactive board.
+const defaultRefresh = 5 * time.Second
+
+// ErrUsage is returned when the command line is not valid, the usage is written to the error output.
+var ErrUsage = errors.New("invalid usage")
+
+const usage = `usage: tt [-config file] [-url url] [-token token] [-json] command [args]
+
+commands:
+  projects                                  list the projects
+  board <project>                           show the board of a project
+  create [-description text] <project> <column> <name>
+                                            create a task at the end of a column
+  move <task> <column> [position]           move a task to a column of its project, to the end by default
+  rename <task> <name>                      rename a task
+  comment <task> <text>                     add a comment to a task
+  ui [-refresh interval] <project>          open the interactive board of a project
+  config [-url url] [-token token]          save the server URL and the token to the config file
+
+Projects and tasks are given by their ID or a unique ID prefix, columns by their ID or name.
+`
+
+// app runs the commands against the API.
+type app struct {
+	client *client.Client
+	out    io.Writer
+	errOut io.Writer
+	json   bool
+}
+
+// Run runs the command line args given without the program name.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("tt", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	configPath := fs.String("config", DefaultConfigPath(), "config file")
+	url := fs.String("url", "", "server URL, overrides the config file")
+	token := fs.String("token", "", "admin token, overrides the config file")
+	jsonOut := fs.Bool("json", false, "print JSON")
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+
+		return ErrUsage
+	}
+	cfg, err := LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+	cmd, cmdArgs := fs.Arg(0), fs.Args()[1:]
+	if cmd == "config" {
+		return runConfig(*configPath, cfg, cmdArgs, stdout, stderr)
+	}
+	if *url != "" {
+		cfg.URL = *url
+	}
+	if *token != "" {
+		cfg.Token = *token
+	}
+	c, err := client.New(cfg.URL, client.WithAdminToken(cfg.Token))
+	if err != nil {
+		return fmt.Errorf("server url: %w", err)
+	}
+	a := &app{client: c, out: stdout, errOut: stderr, json: *jsonOut}
+	switch cmd {
+	case "projects":
+		return a.projects(ctx, cmdArgs)
+	case "board":
+		return a.board(ctx, cmdArgs)
+	case "create":
+		return a.create(ctx, cmdArgs)
+	case "move":
+		return a.move(ctx, cmdArgs)
+	case "rename":
+		return a.rename(ctx, cmdArgs)
+	case "comment":
+		return a.comment(ctx, cmdArgs)
+	case "ui":
+		return a.ui(ctx, cmdArgs)
+	default:
+		fmt.Fprintf(stderr, "tt: unknown command %q\n", cmd)
+		fs.Usage()
+
+		return ErrUsage
+	}
+}
+
+// runConfig saves the flags to the config file, without flags it prints the config.
+func runConfig(path string, cfg Config, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	url := fs.String("url", "", "server URL")
+	token := fs.String("token", "", "admin token")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		return ErrUsage
+	}
+	if fs.NFlag() == 0 {
+		masked := ""
+		if cfg.Token != "" {
+			masked = "(set)"
+		}
+		fmt.Fprintf(stdout, "config: %s\nurl: %s\ntoken: %s\n", path, cfg.URL, masked)
+
+		return nil
+	}
+	if *url != "" {
+		if _, err := client.New(*url); err != nil {
+			return fmt.Errorf("server url: %w", err)
+		}
+		cfg.URL = *url
+	}
+	if *token != "" {
+		cfg.Token = *token
+	}
+	if err := SaveConfig(path, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "saved %s\n", path)
+
+	return nil
+}
+
+func (a *app) usage(format string) error {
+	fmt.Fprintf(a.errOut, "usage: tt %s\n", format)
+
+	return ErrUsage
+}
+
+func (a *app) printJSON(v interface{}) error {
+	enc := json.NewEncoder(a.out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+
+	return nil
+}
+
+func (a *app) projects(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return a.usage("projects")
+	}
+	projects, err := a.client.ListProjects(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	if a.json {
+		return a.printJSON(projects)
+	}
+
+	return writeProjects(a.out, projects)
+}
+
+func (a *app) board(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return a.usage("board <project>")
+	}
+	p, err := a.resolveProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	columns, err := a.client.ListProjectColumns(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("list columns: %w", err)
+	}
+	b := Board{Project: p, Columns: make([]BoardColumn, 0, len(columns))}
+	for _, cl := range columns {
+		tasks, err := a.client.ListColumnTasks(ctx, cl.ID)
+		if err != nil {
+			return fmt.Errorf("list tasks of column %q: %w", cl.Name, err)
+		}
+		b.Columns = append(b.Columns, BoardColumn{Column: cl, Tasks: tasks})
+	}
+	if a.json {
+		return a.printJSON(b)
+	}
+
+	return writeBoard(a.out, &b)
+}
+
+func (a *app) create(ctx context.Context, args []string) error {
+	const format = "create [-description text] <project> <column> <name>"
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	fs.SetOutput(a.errOut)
+	description := fs.String("description", "", "task description, defaults to the name")
+	if err := fs.Parse(args); err != nil || fs.NArg() < 3 {
+		return a.usage(format)
+	}
+	p, err := a.resolveProject(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	cl, err := a.resolveColumn(ctx, p.ID, fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	name := strings.Join(fs.Args()[2:], " ")
+	// The API requires a description.
+	if *description == "" {
+		*description = name
+	}
+	// The API places the task after the last one of the column.
+	// nolint:exhaustivestruct
+	tk, err := a.client.CreateTask(ctx, domain.Task{
+		Name:        name,
+		Description: *description,
+		ColumnID:    cl.ID,
+		Position:    math.MaxInt32,
+	})
+	if err != nil {
+		return fmt.Errorf("create task: %w", err)
+	}
+	if a.json {
+		return a.printJSON(tk)
+	}
+	fmt.Fprintf(a.out, "created task %s in %s\n", tk.ID, cl.Name)
+
+	return nil
+}
+
+func (a *app) move(ctx context.Context, args []string) error {
+	if len(args) != 2 && len(args) != 3 {
+		return a.usage("move <task> <column> [position]")
+	}
+	position := math.MaxInt32
+	if len(args) == 3 {
+		n, err := strconv.Atoi(args[2])
+		if err != nil || n < 0 {
+			return a.usage("move <task> <column> [position]")
+		}
+		position = n
+	}
+	tk, err := a.resolveTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	current, err := a.client.GetColumn(ctx, tk.ColumnID)
+	if err != nil {
+		return fmt.Errorf("get column of the task: %w", err)
+	}
+	cl, err := a.resolveColumn(ctx, current.ProjectID, args[1])
+	if err != nil {
+		return err
+	}
+	patch := map[string]interface{}{"column_id": cl.ID, "position": position}
+	if tk, err = a.client.PatchTask(ctx, tk.ID, patch); err != nil {
+		return fmt.Errorf("move task: %w", err)
+	}
+	if a.json {
+		return a.printJSON(tk)
+	}
+	fmt.Fprintf(a.out, "moved task %s to %s at position %d\n", tk.ID, cl.Name, tk.Position)
+
+	return nil
+}
+
+func (a *app) rename(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return a.usage("rename <task> <name>")
+	}
+	tk, err := a.resolveTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	patch := map[string]interface{}{"name": strings.Join(args[1:], " ")}
+	if tk, err = a.client.PatchTask(ctx, tk.ID, patch); err != nil {
+		return fmt.Errorf("rename task: %w", err)
+	}
+	if a.json {
+		return a.printJSON(tk)
+	}
+	fmt.Fprintf(a.out, "renamed task %s to %q\n", tk.ID, tk.Name)
+
+	return nil
+}
+
+func (a *app) comment(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return a.usage("comment <task> <text>")
+	}
+	tk, err := a.resolveTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	// nolint:exhaustivestruct
+	cm, err := a.client.CreateComment(ctx, domain.Comment{Text: strings.Join(args[1:], " "), TaskID: tk.ID})
+	if err != nil {
+		return fmt.Errorf("add comment: %w", err)
+	}
+	if a.json {
+		return a.printJSON(cm)
+	}
+	fmt.Fprintf(a.out, "added comment %s to task %q\n", cm.ID, tk.Name)
+
+	return nil
+}
+
+func (a *app) ui(ctx context.Context, args []string) error {
+	const format = "ui [-refresh interval] <project>"
+	fs := flag.NewFlagSet("ui", flag.ContinueOnError)
+	fs.SetOutput(a.errOut)
+	refresh := fs.Duration("refresh", defaultRefresh, "interval of reloading the board")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 || *refresh <= 0 {
+		return a.usage(format)
+	}
+	p, err := a.resolveProject(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return tui.Run(ctx, tui.New(a.client, p), os.Stdin, a.out, *refresh)
+}
+
+// resolveProject returns the project with the ID or the unique ID prefix.
+func (a *app) resolveProject(ctx context.Context, arg string) (domain.Project, error) {
+	if id, err := uuid.Parse(arg); err == nil {
+		p, err := a.client.GetProject(ctx, id)
+		if err != nil {
+			return domain.Project{}, fmt.Errorf("get project: %w", err)
+		}
+
+		return p, nil
+	}
+	// nolint:exhaustivestruct
+	projects, err := a.client.ListProjects(ctx, &client.ListOptions{Archived: true})
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("list projects: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(projects))
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	i, err := matchPrefix("project", arg, ids)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	return projects[i], nil
+}
+
+// resolveTask returns the task with the ID or the unique ID prefix.
+func (a *app) resolveTask(ctx context.Context, arg string) (domain.Task, error) {
+	if id, err := uuid.Parse(arg); err == nil {
+		tk, err := a.client.GetTask(ctx, id)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("get task: %w", err)
+		}
+
+		return tk, nil
+	}
+	tasks, err := a.client.ListTasks(ctx, nil)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("list tasks: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(tasks))
+	for _, tk := range tasks {
+		ids = append(ids, tk.ID)
+	}
+	i, err := matchPrefix("task", arg, ids)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	return tasks[i], nil
+}
+
+// resolveColumn returns the column of the project with the ID or the name, ignoring case.
+func (a *app) resolveColumn(ctx context.Context, projectID uuid.UUID, arg string) (domain.Column, error) {
+	columns, err := a.client.ListProjectColumns(ctx, projectID)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("list columns: %w", err)
+	}
+	for _, cl := range columns {
+		if cl.ID.String() == arg || strings.EqualFold(cl.Name, arg) {
+			return cl, nil
+		}
+	}
+
+	return domain.Column{}, fmt.Errorf("column %q: %w", arg, domain.ErrNotFound)
+}
+
+// matchPrefix returns the index of the only id starting with the prefix.
+func matchPrefix(kind, prefix string, ids []uuid.UUID) (int, error) {
+	if prefix == "" {
+		return 0, fmt.Errorf("%s id is empty: %w", kind, domain.ErrBadParamInput)
+	}
+	found := -1
+	for i, id := range ids {
+		if !strings.HasPrefix(id.String(), strings.ToLower(prefix)) {
+			continue
+		}
+		if found >= 0 {
+			return 0, fmt.Errorf("%s %q matches more than one id: %w", kind, prefix, domain.ErrBadParamInput)
+		}
+		found = i
+	}
+	if found < 0 {
+		return 0, fmt.Errorf("%s %q: %w", kind, prefix, domain.ErrNotFound)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,246 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/cli"
+	helper "github.com/matryer/is"
+)
+
+var (
+	projectID = uuid.MustParse("11111111-aaaa-4000-8000-000000000000")
+	todoID    = uuid.MustParse("22222222-aaaa-4000-8000-000000000000")
+	doneID    = uuid.MustParse("33333333-aaaa-4000-8000-000000000000")
+	taskID    = uuid.MustParse("44444444-aaaa-4000-8000-000000000000")
+)
+
+// fakeAPI serves a board with a task in the Todo column and records the written entities.
+type fakeAPI struct {
+	project domain.Project
+	columns []domain.Column
+	task    domain.Task
+	written []interface{}
+}
+
+//nolint:exhaustivestruct
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{
+		project: domain.Project{ID: projectID, Name: "Roadmap", Description: "Plans"},
+		columns: []domain.Column{
+			{ID: todoID, Name: "Todo", Status: "todo", ProjectID: projectID},
+			{ID: doneID, Name: "Done", Status: "done", Position: 1, ProjectID: projectID},
+		},
+		task: domain.Task{ID: taskID, Name: "First", Description: "Details", ColumnID: todoID},
+	}
+}
+
+//nolint:exhaustivestruct
+func (f *fakeAPI) handler() http.Handler {
+	mux := http.NewServeMux()
+	respond := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, []domain.Project{f.project})
+	})
+	mux.HandleFunc("/v1/projects/"+projectID.String(), func(w http.ResponseWriter, r *http.Request) {
+		respond(w, f.project)
+	})
+	mux.HandleFunc("/v1/projects/"+projectID.String()+"/columns", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, f.columns)
+	})
+	mux.HandleFunc("/v1/columns/"+todoID.String(), func(w http.ResponseWriter, r *http.Request) {
+		respond(w, f.columns[0])
+	})
+	mux.HandleFunc("/v1/columns/"+todoID.String()+"/tasks", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, []domain.Task{f.task})
+	})
+	mux.HandleFunc("/v1/columns/"+doneID.String()+"/tasks", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, []domain.Task{})
+	})
+	mux.HandleFunc("/v1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			respond(w, []domain.Task{f.task})
+
+			return
+		}
+		var tk domain.Task
+		_ = json.NewDecoder(r.Body).Decode(&tk)
+		f.written = append(f.written, tk)
+		tk.ID = uuid.New()
+		respond(w, tk)
+	})
+	mux.HandleFunc("/v1/tasks/"+taskID.String(), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			respond(w, f.task)
+
+			return
+		}
+		// The merge patch is applied by decoding it over the stored task.
+		tk := f.task
+		_ = json.NewDecoder(r.Body).Decode(&tk)
+		f.written = append(f.written, tk)
+		respond(w, tk)
+	})
+	mux.HandleFunc("/v1/tasks/"+taskID.String()+"/comments", func(w http.ResponseWriter, r *http.Request) {
+		var cm domain.Comment
+		_ = json.NewDecoder(r.Body).Decode(&cm)
+		f.written = append(f.written, cm)
+		cm.ID = uuid.New()
+		respond(w, cm)
+	})
+
+	return mux
+}
+
+// run runs tt against the fake API with a config file in a temporary directory.
+func run(t *testing.T, f *fakeAPI, args ...string) (string, error) {
+	t.Helper()
+	ts := httptest.NewServer(f.handler())
+	t.Cleanup(ts.Close)
+	var out, errOut bytes.Buffer
+	args = append([]string{"-config", filepath.Join(t.TempDir(), "config.json"), "-url", ts.URL}, args...)
+	err := cli.Run(context.Background(), args, &out, &errOut)
+
+	return out.String(), err
+}
+
+func TestProjects(t *testing.T) {
+	is := helper.New(t)
+
+	out, err := run(t, newFakeAPI(), "projects")
+	is.NoErr(err)
+	is.True(strings.HasPrefix(out, "ID"))
+	is.True(strings.Contains(out, projectID.String()+"  Roadmap"))
+
+	out, err = run(t, newFakeAPI(), "-json", "projects")
+	is.NoErr(err)
+	var got []domain.Project
+	is.NoErr(json.Unmarshal([]byte(out), &got))
+	is.Equal(got[0].Name, "Roadmap")
+}
+
+func TestBoard(t *testing.T) {
+	is := helper.New(t)
+
+	out, err := run(t, newFakeAPI(), "board", "1111")
+	is.NoErr(err)
+	lines := strings.Split(out, "\n")
+	is.Equal(lines[0], "Roadmap ("+projectID.String()+")")
+	is.True(strings.HasPrefix(lines[2], "Todo (1)"))
+	is.True(strings.Contains(lines[2], "Done (0)"))
+	is.Equal(strings.TrimSpace(lines[4]), "44444444 First")
+
+	out, err = run(t, newFakeAPI(), "-json", "board", projectID.String())
+	is.NoErr(err)
+	var got cli.Board
+	is.NoErr(json.Unmarshal([]byte(out), &got))
+	is.Equal(len(got.Columns), 2)
+	is.Equal(got.Columns[0].Tasks[0].ID, taskID)
+}
+
+//nolint:funlen
+func TestTaskCommands(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		is := helper.New(t)
+		f := newFakeAPI()
+
+		out, err := run(t, f, "create", "1111", "done", "Write", "docs")
+		is.NoErr(err)
+		is.True(strings.Contains(out, "in Done"))
+		tk, _ := f.written[0].(domain.Task)
+		is.Equal(tk.Name, "Write docs")
+		is.Equal(tk.Description, "Write docs")
+		is.Equal(tk.ColumnID, doneID)
+		is.Equal(tk.Position, math.MaxInt32)
+	})
+	t.Run("move", func(t *testing.T) {
+		is := helper.New(t)
+		f := newFakeAPI()
+
+		_, err := run(t, f, "move", "4444", "Done")
+		is.NoErr(err)
+		tk, _ := f.written[0].(domain.Task)
+		is.Equal(tk.ID, taskID)
+		is.Equal(tk.ColumnID, doneID)
+		is.Equal(tk.Position, math.MaxInt32)
+
+		_, err = run(t, f, "move", taskID.String(), "Todo", "0")
+		is.NoErr(err)
+		tk, _ = f.written[1].(domain.Task)
+		is.Equal(tk.Position, 0)
+
+		_, err = run(t, f, "move", "4444", "Review")
+		is.True(errors.Is(err, domain.ErrNotFound))
+	})
+	t.Run("rename", func(t *testing.T) {
+		is := helper.New(t)
+		f := newFakeAPI()
+
+		out, err := run(t, f, "-json", "rename", "4444", "Renamed")
+		is.NoErr(err)
+		var got domain.Task
+		is.NoErr(json.Unmarshal([]byte(out), &got))
+		is.Equal(got.Name, "Renamed")
+		is.Equal(got.Description, "Details")
+	})
+	t.Run("comment", func(t *testing.T) {
+		is := helper.New(t)
+		f := newFakeAPI()
+
+		out, err := run(t, f, "comment", "4444", "looks", "good")
+		is.NoErr(err)
+		is.True(strings.Contains(out, `to task "First"`))
+		cm, _ := f.written[0].(domain.Comment)
+		is.Equal(cm.Text, "looks good")
+		is.Equal(cm.TaskID, taskID)
+	})
+	t.Run("unknown task", func(t *testing.T) {
+		is := helper.New(t)
+
+		_, err := run(t, newFakeAPI(), "rename", "5555", "Renamed")
+		is.True(errors.Is(err, domain.ErrNotFound))
+	})
+}
+
+func TestUsage(t *testing.T) {
+	is := helper.New(t)
+
+	_, err := run(t, newFakeAPI(), "archive")
+	is.True(errors.Is(err, cli.ErrUsage))
+	_, err = run(t, newFakeAPI(), "move", "4444")
+	is.True(errors.Is(err, cli.ErrUsage))
+}
+
+func TestConfig(t *testing.T) {
+	is := helper.New(t)
+	path := filepath.Join(t.TempDir(), "tt", "config.json")
+
+	cfg, err := cli.LoadConfig(path)
+	is.NoErr(err)
+	is.Equal(cfg, cli.Config{URL: cli.DefaultURL, Token: ""})
+
+	var out bytes.Buffer
+	err = cli.Run(context.Background(), []string{
+		"-config", path, "config", "-url", "http://tracker:3000", "-token", "secret",
+	}, &out, &out)
+	is.NoErr(err)
+	cfg, err = cli.LoadConfig(path)
+	is.NoErr(err)
+	is.Equal(cfg, cli.Config{URL: "http://tracker:3000", Token: "secret"})
+
+	err = cli.Run(context.Background(), []string{"-config", path, "config", "-url", "tracker"}, &out, &out)
+	is.True(err != nil)
+}
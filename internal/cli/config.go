@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// DefaultURL is the server URL used when the config file has none.
+const DefaultURL = "http://localhost:3000"
+
+// Config represent the config file of the CLI.
+type Config struct {
+	// URL is the base URL of the API server.
+	URL string `json:"url"`
+	// Token is the admin token sent with the requests.
+	Token string `json:"token,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the config file in the user config directory.
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return filepath.Join(".tt", "config.json")
+	}
+
+	return filepath.Join(dir, "tt", "config.json")
+}
+
+// LoadConfig reads the config file at path, a missing file gives the default config.
+func LoadConfig(path string) (Config, error) {
+	cfg := Config{URL: DefaultURL, Token: ""}
+	b, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return Config{}, fmt.Errorf("decode config %s: %w", path, err)
+	}
+	if cfg.URL == "" {
+		cfg.URL = DefaultURL
+	}
+
+	return cfg, nil
+}
+
+// SaveConfig writes the config file at path, readable by the user only as it holds the token.
+func SaveConfig(path string, cfg Config) error {
+	b, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	if err := ioutil.WriteFile(path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// The widths of the table cells in runes.
+const (
+	shortIDWidth     = 8
+	cellWidth        = 32
+	descriptionWidth = 60
+)
+
+// Board represent a project with the tasks of its columns in position order.
+type Board struct {
+	Project domain.Project `json:"project"`
+	Columns []BoardColumn  `json:"columns"`
+}
+
+// BoardColumn represent a column of a board with its tasks.
+type BoardColumn struct {
+	Column domain.Column `json:"column"`
+	Tasks  []domain.Task `json:"tasks"`
+}
+
+func newTable(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+}
+
+func writeProjects(w io.Writer, projects []domain.Project) error {
+	tw := newTable(w)
+	fmt.Fprintln(tw, "ID\tNAME\tDESCRIPTION")
+	for _, p := range projects {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", p.ID, cell(p.Name, cellWidth), cell(p.Description, descriptionWidth))
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("write projects: %w", err)
+	}
+
+	return nil
+}
+
+// writeBoard writes the columns of the board side by side, the tasks are prefixed with their short ids.
+func writeBoard(w io.Writer, b *Board) error {
+	fmt.Fprintf(w, "%s (%s)\n\n", b.Project.Name, b.Project.ID)
+	tw := newTable(w)
+	rows := 0
+	header := make([]string, 0, len(b.Columns))
+	rule := make([]string, 0, len(b.Columns))
+	for _, c := range b.Columns {
+		title := cell(fmt.Sprintf("%s (%d)", c.Column.Name, len(c.Tasks)), cellWidth)
+		header = append(header, title)
+		rule = append(rule, strings.Repeat("-", len([]rune(title))))
+		if len(c.Tasks) > rows {
+			rows = len(c.Tasks)
+		}
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	fmt.Fprintln(tw, strings.Join(rule, "\t"))
+	for i := 0; i < rows; i++ {
+		row := make([]string, 0, len(b.Columns))
+		for _, c := range b.Columns {
+			if i >= len(c.Tasks) {
+				row = append(row, "")
+
+				continue
+			}
+			tk := c.Tasks[i]
+			row = append(row, cell(tk.ID.String()[:shortIDWidth]+" "+tk.Name, cellWidth))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("write board: %w", err)
+	}
+
+	return nil
+}
+
+// cell returns the first line of s cut to n runes.
+func cell(s string, n int) string {
+	if i := strings.IndexAny(s, "\r\n"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.ReplaceAll(s, "\t", " ")
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,238 @@
+// Package github imports the issues dumped from the GitHub REST API.
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// Issue represent the parts of a GitHub issue used by the import.
+type Issue struct {
+	Number      int             `json:"number"`
+	Title       string          `json:"title"`
+	Body        string          `json:"body"`
+	State       string          `json:"state"`
+	HTMLURL     string          `json:"html_url"`
+	URL         string          `json:"url"`
+	Labels      []Label         `json:"labels"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// Label represent a GitHub label of an issue.
+type Label struct {
+	Name string `json:"name"`
+}
+
+// Comment represent a GitHub issue comment.
+type Comment struct {
+	IssueURL  string    `json:"issue_url"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// ParseIssues reads the issues dumped from the list repository issues endpoint.
+func ParseIssues(r io.Reader) ([]Issue, error) {
+	var issues []Issue
+	if err := json.NewDecoder(r).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("decode github issues: %w", err)
+	}
+
+	return issues, nil
+}
+
+// ParseComments reads the comments dumped from the list repository issue comments endpoint.
+func ParseComments(r io.Reader) ([]Comment, error) {
+	var comments []Comment
+	if err := json.NewDecoder(r).Decode(&comments); err != nil {
+		return nil, fmt.Errorf("decode github comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// Plan represent the tasks and comments created by an import.
+type Plan struct {
+	Open   []PlannedTask
+	Closed []PlannedTask
+	// Skipped is the number of the pull requests left out of the import.
+	Skipped int
+}
+
+// PlannedTask represent a task created from an issue with its comments in time order.
+type PlannedTask struct {
+	Name        string
+	Description string
+	Comments    []domain.Comment
+}
+
+// Comments returns the number of the planned comments.
+func (p *Plan) Comments() int {
+	n := 0
+	for _, tasks := range [][]PlannedTask{p.Open, p.Closed} {
+		for _, tk := range tasks {
+			n += len(tk.Comments)
+		}
+	}
+
+	return n
+}
+
+// NewPlan maps the issues in number order to tasks, the pull requests are skipped.
+// The tracker has no labels, so they are appended to the task description.
+func NewPlan(issues []Issue, comments []Comment) Plan {
+	byIssue := make(map[string][]Comment)
+	for _, c := range comments {
+		byIssue[c.IssueURL] = append(byIssue[c.IssueURL], c)
+	}
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Number < issues[j].Number })
+	var plan Plan
+	for i := range issues {
+		issue := &issues[i]
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			plan.Skipped++
+
+			continue
+		}
+		tk := plannedTask(issue, byIssue[issue.URL])
+		if issue.State == "closed" {
+			plan.Closed = append(plan.Closed, tk)
+
+			continue
+		}
+		plan.Open = append(plan.Open, tk)
+	}
+
+	return plan
+}
+
+func plannedTask(issue *Issue, comments []Comment) PlannedTask {
+	var desc strings.Builder
+	desc.WriteString(issue.Body)
+	if len(issue.Labels) > 0 {
+		labels := make([]string, 0, len(issue.Labels))
+		for _, l := range issue.Labels {
+			labels = append(labels, l.Name)
+		}
+		fmt.Fprintf(&desc, "\n\nLabels: %s", strings.Join(labels, ", "))
+	}
+	if issue.HTMLURL != "" {
+		fmt.Fprintf(&desc, "\n\nImported from %s", issue.HTMLURL)
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+	tk := PlannedTask{
+		Name:        domain.Truncate(fmt.Sprintf("#%d %s", issue.Number, issue.Title), domain.MaxTaskName),
+		Description: domain.Truncate(strings.TrimSpace(desc.String()), domain.MaxTaskDescription),
+		Comments:    make([]domain.Comment, 0, len(comments)),
+	}
+	for _, c := range comments {
+		text := c.Body
+		if c.User.Login != "" {
+			text = c.User.Login + ": " + text
+		}
+		// nolint:exhaustivestruct
+		tk.Comments = append(tk.Comments, domain.Comment{
+			Text:      domain.Truncate(text, domain.MaxCommentText),
+			CreatedAt: c.CreatedAt.UTC(),
+		})
+	}
+
+	return tk
+}
+
+// Importer creates the planned tasks in a project. The tasks are stored through the usecase,
+// the comments are stored by the repository to keep the time they were made on GitHub.
+type Importer struct {
+	projects domain.ProjectUsecase
+	tasks    domain.TaskUsecase
+	comments domain.CommentRepository
+}
+
+// New will create new an Importer with the usecases creating the tasks and the comment repository.
+func New(p domain.ProjectUsecase, t domain.TaskUsecase, c domain.CommentRepository) *Importer {
+	return &Importer{projects: p, tasks: t, comments: c}
+}
+
+// Columns names the columns of the open and the closed issues.
+type Columns struct {
+	Open   string
+	Closed string
+}
+
+// Import appends the open and the closed issues to the named columns of the project,
+// the missing columns are created. The items created before a failure are left in place.
+func (i *Importer) Import(ctx context.Context, projectID uuid.UUID, columns Columns, plan *Plan) error {
+	existing, err := i.projects.FetchColumns(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("fetch columns: %w", err)
+	}
+	openID, err := i.column(ctx, projectID, existing, columns.Open, domain.CategoryTodo)
+	if err != nil {
+		return err
+	}
+	if err = i.importTasks(ctx, openID, plan.Open); err != nil {
+		return err
+	}
+	if len(plan.Closed) == 0 {
+		return nil
+	}
+	closedID, err := i.column(ctx, projectID, existing, columns.Closed, domain.CategoryDone)
+	if err != nil {
+		return err
+	}
+
+	return i.importTasks(ctx, closedID, plan.Closed)
+}
+
+// column returns the id of the column with the name, storing a new one of the category when there is none.
+func (i *Importer) column(
+	ctx context.Context,
+	projectID uuid.UUID,
+	existing []domain.Column,
+	name string,
+	category domain.Category,
+) (uuid.UUID, error) {
+	for _, c := range existing {
+		if c.Name == name {
+			return c.ID, nil
+		}
+	}
+	// nolint:exhaustivestruct
+	column := domain.Column{Name: name, Status: name, Category: category, Position: math.MaxInt32, ProjectID: projectID}
+	if err := i.projects.StoreColumn(ctx, &column); err != nil {
+		return uuid.Nil, fmt.Errorf("store column %q: %w", name, err)
+	}
+
+	return column.ID, nil
+}
+
+func (i *Importer) importTasks(ctx context.Context, columnID uuid.UUID, tasks []PlannedTask) error {
+	for _, pt := range tasks {
+		// The usecase places the task after the last one of the column.
+		// nolint:exhaustivestruct
+		tk := domain.Task{Name: pt.Name, Description: pt.Description, ColumnID: columnID, Position: math.MaxInt32}
+		if err := i.tasks.Store(ctx, &tk); err != nil {
+			return fmt.Errorf("store task %q: %w", pt.Name, err)
+		}
+		for n := range pt.Comments {
+			cm := pt.Comments[n]
+			cm.TaskID = tk.ID
+			if err := i.comments.Store(ctx, &cm); err != nil {
+				return fmt.Errorf("store comment of task %q: %w", pt.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
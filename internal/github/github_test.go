@@ -0,0 +1,102 @@
+package github_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	mocks "github.com/igkostyuk/tasktracker/domain/mock"
+	"github.com/igkostyuk/tasktracker/internal/github"
+	helper "github.com/matryer/is"
+)
+
+const issues = `[
+  {"number": 3, "title": "Crash", "body": "Stack trace", "state": "closed",
+    "url": "https://api.github.com/repos/o/r/issues/3", "html_url": "https://github.com/o/r/issues/3",
+    "labels": [{"name": "bug"}]},
+  {"number": 1, "title": "Feature", "body": "", "state": "open",
+    "url": "https://api.github.com/repos/o/r/issues/1", "html_url": "https://github.com/o/r/issues/1"},
+  {"number": 2, "title": "Fix", "state": "open", "pull_request": {"url": "https://api.github.com/repos/o/r/pulls/2"}}
+]`
+
+const comments = `[
+  {"issue_url": "https://api.github.com/repos/o/r/issues/3", "body": "fixed", "created_at": "2020-02-02T10:00:00Z",
+    "user": {"login": "ann"}},
+  {"issue_url": "https://api.github.com/repos/o/r/issues/3", "body": "seen", "created_at": "2020-02-01T10:00:00Z",
+    "user": {"login": "bob"}}
+]`
+
+func plan(t *testing.T) github.Plan {
+	t.Helper()
+	is := helper.New(t)
+
+	list, err := github.ParseIssues(strings.NewReader(issues))
+	is.NoErr(err)
+	cs, err := github.ParseComments(strings.NewReader(comments))
+	is.NoErr(err)
+
+	return github.NewPlan(list, cs)
+}
+
+func TestNewPlan(t *testing.T) {
+	is := helper.New(t)
+
+	p := plan(t)
+	is.Equal(p.Skipped, 1)
+	is.Equal(len(p.Open), 1)
+	is.Equal(p.Open[0].Name, "#1 Feature")
+	is.Equal(p.Open[0].Description, "Imported from https://github.com/o/r/issues/1")
+	is.Equal(len(p.Closed), 1)
+	is.Equal(p.Closed[0].Description, "Stack trace\n\nLabels: bug\n\nImported from https://github.com/o/r/issues/3")
+	is.Equal(p.Comments(), 2)
+	is.Equal(p.Closed[0].Comments[0].Text, "bob: seen")
+	is.Equal(p.Closed[0].Comments[0].CreatedAt, time.Date(2020, 2, 1, 10, 0, 0, 0, time.UTC))
+}
+
+//nolint:exhaustivestruct
+func TestImport(t *testing.T) {
+	is := helper.New(t)
+
+	p := plan(t)
+	projectID, todoID := uuid.New(), uuid.New()
+	mp := &mocks.ProjectUsecaseMock{
+		FetchColumnsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: todoID, Name: "Todo", ProjectID: id}}, nil
+		},
+		StoreColumnFunc: func(ctx context.Context, c *domain.Column) error {
+			c.ID = uuid.New()
+
+			return nil
+		},
+	}
+	mt := &mocks.TaskUsecaseMock{
+		StoreFunc: func(ctx context.Context, tk *domain.Task) error {
+			tk.ID = uuid.New()
+
+			return nil
+		},
+	}
+	mc := &mocks.CommentRepositoryMock{
+		StoreFunc: func(ctx context.Context, ct *domain.Comment) error {
+			return nil
+		},
+	}
+	err := github.New(mp, mt, mc).Import(context.TODO(), projectID, github.Columns{Open: "Todo", Closed: "Done"}, &p)
+	is.NoErr(err)
+
+	cs := mp.StoreColumnCalls()
+	is.Equal(len(cs), 1)
+	is.Equal(cs[0].In2.Name, "Done")
+	is.Equal(cs[0].In2.Category, domain.CategoryDone)
+	ts := mt.StoreCalls()
+	is.Equal(len(ts), 2)
+	is.Equal(ts[0].In2.ColumnID, todoID)
+	is.Equal(ts[1].In2.ColumnID, cs[0].In2.ID)
+	cms := mc.StoreCalls()
+	is.Equal(len(cms), 2)
+	is.Equal(cms[1].Ct.TaskID, ts[1].In2.ID)
+	is.Equal(cms[1].Ct.CreatedAt, time.Date(2020, 2, 2, 10, 0, 0, 0, time.UTC))
+}
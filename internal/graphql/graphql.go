@@ -0,0 +1,92 @@
+// Package graphql serves the tasktracker GraphQL API on top of the domain usecases.
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	gql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/web"
+)
+
+// errMethodNotAllowed will throw if a GraphQL request is not sent with POST.
+var errMethodNotAllowed = errors.New("method not allowed, GraphQL requests must be sent with POST")
+
+// request represent a GraphQL request sent as JSON.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type handler struct {
+	schema         *gql.Schema
+	columnUsecase  domain.ColumnUsecase
+	taskUsecase    domain.TaskUsecase
+	commentUsecase domain.CommentUsecase
+}
+
+// New return the GraphQL endpoint resolving the queries and mutations with the usecases.
+func New(p domain.ProjectUsecase, c domain.ColumnUsecase, t domain.TaskUsecase, cm domain.CommentUsecase) http.Handler {
+	r := &resolver{projectUsecase: p, columnUsecase: c, taskUsecase: t, commentUsecase: cm}
+
+	return &handler{
+		schema:         gql.MustParseSchema(schema, r),
+		columnUsecase:  c,
+		taskUsecase:    t,
+		commentUsecase: cm,
+	}
+}
+
+// ServeHTTP godoc
+// @Summary GraphQL endpoint
+// @Description execute a GraphQL query or mutation over projects, columns, tasks and comments
+// @Tags graphql
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} object "GraphQL response with data and errors"
+// @Failure 422 {object} web.Problem
+// @Router /graphql [post]
+// ServeHTTP will execute GraphQL request.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		web.RespondRequestError(w, r, errMethodNotAllowed, http.StatusMethodNotAllowed)
+
+		return
+	}
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	ctx := withLoaders(r.Context(), newLoaders(h.columnUsecase, h.taskUsecase, h.commentUsecase))
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	publicErrors(r, response.Errors)
+	web.Respond(w, r, response, http.StatusOK)
+}
+
+// publicErrors sends the registered resolver errors with their public message, problem type and detail,
+// the server errors are logged and sent as an internal error like on the REST routes.
+func publicErrors(r *http.Request, errs []*gqlerrors.QueryError) {
+	for _, e := range errs {
+		err := e.ResolverError
+		if err == nil {
+			continue
+		}
+		if web.StatusCode(err) >= http.StatusInternalServerError {
+			web.LogError(r, err)
+			e.Message = domain.ErrInternalServerError.Error()
+
+			continue
+		}
+		if spec, ok := web.LookupError(err); ok {
+			e.Message = spec.Message
+			e.Extensions = map[string]interface{}{"type": spec.Type, "detail": err.Error()}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	mocks "github.com/igkostyuk/tasktracker/domain/mock"
+	"github.com/igkostyuk/tasktracker/internal/graphql"
+	"github.com/igkostyuk/tasktracker/internal/web"
+	helper "github.com/matryer/is"
+)
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string   `json:"message"`
+		Path       []string `json:"path"`
+		Extensions struct {
+			Type   string `json:"type"`
+			Detail string `json:"detail"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func do(t *testing.T, h http.Handler, query string, variables map[string]interface{}) response {
+	t.Helper()
+	is := helper.New(t)
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	is.NoErr(err)
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/", strings.NewReader(string(body)))
+	is.NoErr(err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, request)
+	is.Equal(rec.Code, http.StatusOK)
+	var resp response
+	is.NoErr(json.NewDecoder(rec.Body).Decode(&resp))
+
+	return resp
+}
+
+//nolint:exhaustivestruct,funlen
+func TestNestedQuery(t *testing.T) {
+	is := helper.New(t)
+
+	first, second := uuid.New(), uuid.New()
+	todo, done, other := uuid.New(), uuid.New(), uuid.New()
+	taskA, taskB := uuid.New(), uuid.New()
+	mp := &mocks.ProjectUsecaseMock{
+		FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
+			return []domain.Project{{ID: first, Name: "first"}, {ID: second, Name: "second"}}, nil
+		},
+	}
+	mc := &mocks.ColumnUsecaseMock{
+		FetchByProjectIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{
+				{ID: todo, Name: "todo", ProjectID: first},
+				{ID: done, Name: "done", ProjectID: first, Position: 1},
+				{ID: other, Name: "other", ProjectID: second},
+			}, nil
+		},
+	}
+	mt := &mocks.TaskUsecaseMock{
+		FetchByColumnIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{{ID: taskA, Name: "a", ColumnID: todo}, {ID: taskB, Name: "b", ColumnID: other}}, nil
+		},
+	}
+	mcm := &mocks.CommentUsecaseMock{
+		FetchByTaskIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]domain.Comment, error) {
+			return []domain.Comment{{ID: uuid.New(), Text: "note", TaskID: taskB}}, nil
+		},
+	}
+	h := graphql.New(mp, mc, mt, mcm)
+
+	resp := do(t, h, `{ projects { name columns { name tasks { name comments { text } } } } }`, nil)
+	is.Equal(len(resp.Errors), 0)
+	is.Equal(string(resp.Data), `{"projects":[`+
+		`{"name":"first","columns":[{"name":"todo","tasks":[{"name":"a","comments":[]}]},{"name":"done","tasks":[]}]},`+
+		`{"name":"second","columns":[{"name":"other","tasks":[{"name":"b","comments":[{"text":"note"}]}]}]}]}`)
+
+	// Every level is fetched with one call.
+	is.Equal(len(mc.FetchByProjectIDsCalls()), 1)
+	is.Equal(len(mc.FetchByProjectIDsCalls()[0].Ids), 2)
+	is.Equal(len(mt.FetchByColumnIDsCalls()), 1)
+	is.Equal(len(mt.FetchByColumnIDsCalls()[0].Ids), 3)
+	is.Equal(len(mcm.FetchByTaskIDsCalls()), 1)
+	is.Equal(len(mcm.FetchByTaskIDsCalls()[0].Ids), 2)
+}
+
+//nolint:exhaustivestruct
+func TestQueryNotFound(t *testing.T) {
+	is := helper.New(t)
+
+	mp := &mocks.ProjectUsecaseMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{}, domain.ErrNotFound
+		},
+	}
+	h := graphql.New(mp, &mocks.ColumnUsecaseMock{}, &mocks.TaskUsecaseMock{}, &mocks.CommentUsecaseMock{})
+
+	for _, id := range []string{uuid.New().String(), "not-an-id"} {
+		resp := do(t, h, `query($id: ID!) { project(id: $id) { name } }`, map[string]interface{}{"id": id})
+		is.Equal(len(resp.Errors), 1)
+		is.Equal(resp.Errors[0].Message, "Item not found")
+		is.Equal(resp.Errors[0].Extensions.Type, "urn:tasktracker:problem:not-found")
+		is.Equal(resp.Errors[0].Path, []string{"project"})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestQueryInternalError(t *testing.T) {
+	is := helper.New(t)
+
+	mp := &mocks.ProjectUsecaseMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{}, errors.New("query error: pq: connection refused")
+		},
+	}
+	h := graphql.New(mp, &mocks.ColumnUsecaseMock{}, &mocks.TaskUsecaseMock{}, &mocks.CommentUsecaseMock{})
+
+	resp := do(t, h, `query($id: ID!) { project(id: $id) { name } }`, map[string]interface{}{"id": uuid.New().String()})
+	is.Equal(len(resp.Errors), 1)
+	is.Equal(resp.Errors[0].Message, domain.ErrInternalServerError.Error())
+	is.Equal(resp.Errors[0].Extensions.Detail, "")
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	is := helper.New(t)
+
+	//nolint:exhaustivestruct
+	h := graphql.New(
+		&mocks.ProjectUsecaseMock{}, &mocks.ColumnUsecaseMock{}, &mocks.TaskUsecaseMock{}, &mocks.CommentUsecaseMock{},
+	)
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+	is.NoErr(err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, request)
+	is.Equal(rec.Code, http.StatusMethodNotAllowed)
+	is.Equal(rec.Header().Get("Allow"), http.MethodPost)
+	var p web.Problem
+	is.NoErr(json.NewDecoder(rec.Body).Decode(&p))
+	is.Equal(p.Title, "Method Not Allowed")
+	is.True(strings.Contains(p.Detail, "POST"))
+}
+
+//nolint:exhaustivestruct,funlen
+func TestTaskMutations(t *testing.T) {
+	is := helper.New(t)
+
+	columnID := uuid.New()
+	stored := domain.Task{ID: uuid.New(), Name: "task", Description: "text", ColumnID: columnID, Position: 3}
+	mt := &mocks.TaskUsecaseMock{
+		StoreFunc: func(ctx context.Context, tk *domain.Task) error {
+			tk.ID = stored.ID
+
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+			return stored, nil
+		},
+		UpdateFunc: func(ctx context.Context, tk *domain.Task) error {
+			stored = *tk
+
+			return nil
+		},
+	}
+	h := graphql.New(&mocks.ProjectUsecaseMock{}, &mocks.ColumnUsecaseMock{}, mt, &mocks.CommentUsecaseMock{})
+
+	resp := do(t, h, `mutation($in: TaskInput!) { createTask(input: $in) { id name position } }`,
+		map[string]interface{}{"in": map[string]interface{}{
+			"name": "task", "description": "text", "columnId": columnID.String(), "position": 1,
+		}})
+	is.Equal(len(resp.Errors), 0)
+	is.Equal(string(resp.Data), `{"createTask":{"id":"`+stored.ID.String()+`","name":"task","position":1}}`)
+
+	resp = do(t, h, `mutation($in: TaskInput!) { createTask(input: $in) { id } }`,
+		map[string]interface{}{"in": map[string]interface{}{"name": "", "description": "text", "columnId": "x"}})
+	is.Equal(len(resp.Errors), 1)
+	is.Equal(resp.Errors[0].Message, "Request parameter is not valid")
+	is.True(strings.Contains(resp.Errors[0].Extensions.Detail, "columnId"))
+	is.Equal(len(mt.StoreCalls()), 1)
+
+	resp = do(t, h, `mutation($id: ID!, $in: TaskInput!) { updateTask(id: $id, input: $in) { name position } }`,
+		map[string]interface{}{"id": stored.ID.String(), "in": map[string]interface{}{
+			"name": "renamed", "description": "text", "columnId": columnID.String(),
+		}})
+	is.Equal(len(resp.Errors), 0)
+	is.Equal(string(resp.Data), `{"updateTask":{"name":"renamed","position":3}}`)
+	is.Equal(mt.UpdateCalls()[0].Tk.Position, 3)
+}
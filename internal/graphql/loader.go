@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// batchFunc fetches the items of all the keys at once, grouped by key.
+type batchFunc func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]interface{}, error)
+
+// loader batches the loads of the items by key. The keys are queued by prime and by load,
+// the first load of a queued key fetches all the queued keys in one batch.
+type loader struct {
+	fetch batchFunc
+
+	mu      sync.Mutex
+	pending []uuid.UUID
+	queued  map[uuid.UUID]bool
+	batches map[uuid.UUID]*batch
+}
+
+// batch represent a fetch of the keys, done is closed when items and err are set.
+type batch struct {
+	done  chan struct{}
+	items map[uuid.UUID]interface{}
+	err   error
+}
+
+func newLoader(fetch batchFunc) *loader {
+	return &loader{
+		fetch:   fetch,
+		mu:      sync.Mutex{},
+		pending: nil,
+		queued:  make(map[uuid.UUID]bool),
+		batches: make(map[uuid.UUID]*batch),
+	}
+}
+
+// prime queues the keys to be fetched with the next batch.
+func (l *loader) prime(keys ...uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		l.queue(key)
+	}
+}
+
+func (l *loader) queue(key uuid.UUID) {
+	if l.queued[key] || l.batches[key] != nil {
+		return
+	}
+	l.queued[key] = true
+	l.pending = append(l.pending, key)
+}
+
+// load returns the items of the key, fetching the queued keys when the key is not fetched yet.
+func (l *loader) load(ctx context.Context, key uuid.UUID) (interface{}, error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		l.queue(key)
+		b = &batch{done: make(chan struct{}), items: nil, err: nil}
+		keys := l.pending
+		for _, k := range keys {
+			l.batches[k] = b
+		}
+		l.pending = nil
+		l.queued = make(map[uuid.UUID]bool)
+		l.mu.Unlock()
+		b.items, b.err = l.fetch(ctx, keys)
+		close(b.done)
+	} else {
+		l.mu.Unlock()
+	}
+	select {
+	case <-b.done:
+		return b.items[key], b.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("load: %w", ctx.Err())
+	}
+}
+
+// loaders batch the repository calls of the nested fields of a request.
+// A fetched level primes the next one, so every level of a query is fetched with one call.
+type loaders struct {
+	columns  *loader // columns by project id
+	tasks    *loader // tasks by column id
+	comments *loader // comments by task id
+}
+
+func newLoaders(cu domain.ColumnUsecase, tu domain.TaskUsecase, cmu domain.CommentUsecase) *loaders {
+	l := &loaders{columns: nil, tasks: nil, comments: nil}
+	l.columns = newLoader(func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+		columns, err := cu.FetchByProjectIDs(ctx, keys)
+		if err != nil {
+			return nil, fmt.Errorf("fetch columns by project ids: %w", err)
+		}
+		byProject := make(map[uuid.UUID][]domain.Column, len(keys))
+		for _, c := range columns {
+			byProject[c.ProjectID] = append(byProject[c.ProjectID], c)
+			l.tasks.prime(c.ID)
+		}
+		items := make(map[uuid.UUID]interface{}, len(byProject))
+		for id, cls := range byProject {
+			items[id] = cls
+		}
+
+		return items, nil
+	})
+	l.tasks = newLoader(func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+		tasks, err := tu.FetchByColumnIDs(ctx, keys)
+		if err != nil {
+			return nil, fmt.Errorf("fetch tasks by column ids: %w", err)
+		}
+		byColumn := make(map[uuid.UUID][]domain.Task, len(keys))
+		for _, tk := range tasks {
+			byColumn[tk.ColumnID] = append(byColumn[tk.ColumnID], tk)
+			l.comments.prime(tk.ID)
+		}
+		items := make(map[uuid.UUID]interface{}, len(byColumn))
+		for id, tks := range byColumn {
+			items[id] = tks
+		}
+
+		return items, nil
+	})
+	l.comments = newLoader(func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+		comments, err := cmu.FetchByTaskIDs(ctx, keys)
+		if err != nil {
+			return nil, fmt.Errorf("fetch comments by task ids: %w", err)
+		}
+		byTask := make(map[uuid.UUID][]domain.Comment, len(keys))
+		for _, cm := range comments {
+			byTask[cm.TaskID] = append(byTask[cm.TaskID], cm)
+		}
+		items := make(map[uuid.UUID]interface{}, len(byTask))
+		for id, cms := range byTask {
+			items[id] = cms
+		}
+
+		return items, nil
+	})
+
+	return l
+}
+
+func (l *loaders) columnsOf(ctx context.Context, projectID uuid.UUID) ([]domain.Column, error) {
+	items, err := l.columns.load(ctx, projectID)
+	columns, _ := items.([]domain.Column)
+
+	return columns, err
+}
+
+func (l *loaders) tasksOf(ctx context.Context, columnID uuid.UUID) ([]domain.Task, error) {
+	items, err := l.tasks.load(ctx, columnID)
+	tasks, _ := items.([]domain.Task)
+
+	return tasks, err
+}
+
+func (l *loaders) commentsOf(ctx context.Context, taskID uuid.UUID) ([]domain.Comment, error) {
+	items, err := l.comments.load(ctx, taskID)
+	comments, _ := items.([]domain.Comment)
+
+	return comments, err
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+
+	return l
+}
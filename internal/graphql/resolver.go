@@ -0,0 +1,380 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// resolver resolves the queries and mutations through the usecases.
+type resolver struct {
+	projectUsecase domain.ProjectUsecase
+	columnUsecase  domain.ColumnUsecase
+	taskUsecase    domain.TaskUsecase
+	commentUsecase domain.CommentUsecase
+}
+
+type idArgs struct {
+	ID gql.ID
+}
+
+// parseID returns the uuid of the id, the ids that are not uuids are not found.
+func parseID(id gql.ID) (uuid.UUID, error) {
+	u, err := uuid.Parse(string(id))
+	if err != nil {
+		return uuid.Nil, domain.ErrNotFound
+	}
+
+	return u, nil
+}
+
+func isValid(m interface{}) error {
+	if err := validator.New().Struct(m); err != nil {
+		return fmt.Errorf("validation: %w", err)
+	}
+
+	return nil
+}
+
+func optionalTime(t *time.Time) *gql.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &gql.Time{Time: *t}
+}
+
+func (r *resolver) Projects(ctx context.Context) ([]*projectResolver, error) {
+	// nolint:exhaustivestruct
+	projects, err := r.projectUsecase.Fetch(ctx, domain.Filter{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*projectResolver, 0, len(projects))
+	for _, p := range projects {
+		loadersFrom(ctx).columns.prime(p.ID)
+		result = append(result, &projectResolver{project: p})
+	}
+
+	return result, nil
+}
+
+func (r *resolver) Project(ctx context.Context, args idArgs) (*projectResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := r.projectUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectResolver{project: project}, nil
+}
+
+func (r *resolver) Column(ctx context.Context, args idArgs) (*columnResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	column, err := r.columnUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &columnResolver{column: column}, nil
+}
+
+func (r *resolver) Task(ctx context.Context, args idArgs) (*taskResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	task, err := r.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskResolver{task: task}, nil
+}
+
+func (r *resolver) Comment(ctx context.Context, args idArgs) (*commentResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := r.commentUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentResolver{comment: comment}, nil
+}
+
+type projectInput struct {
+	Name        string
+	Description string
+}
+
+func (r *resolver) CreateProject(ctx context.Context, args struct{ Input projectInput }) (*projectResolver, error) {
+	// nolint:exhaustivestruct
+	project := domain.Project{Name: args.Input.Name, Description: args.Input.Description}
+	if err := isValid(&project); err != nil {
+		return nil, err
+	}
+	if err := r.projectUsecase.Store(ctx, &project); err != nil {
+		return nil, err
+	}
+
+	return &projectResolver{project: project}, nil
+}
+
+func (r *resolver) UpdateProject(ctx context.Context, args struct {
+	ID    gql.ID
+	Input projectInput
+}) (*projectResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	project := domain.Project{ID: id, Name: args.Input.Name, Description: args.Input.Description}
+	if err = isValid(&project); err != nil {
+		return nil, err
+	}
+	if err = r.projectUsecase.Update(ctx, &project); err != nil {
+		return nil, err
+	}
+	// Read the project back to resolve the timestamps set by the database.
+	return r.Project(ctx, idArgs{ID: args.ID})
+}
+
+func (r *resolver) DeleteProject(ctx context.Context, args idArgs) (gql.ID, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err = r.projectUsecase.Delete(ctx, id); err != nil {
+		return "", err
+	}
+
+	return args.ID, nil
+}
+
+type columnInput struct {
+	Name     string
+	Status   string
+	Category *string
+	Position *int32
+	WipLimit *int32
+}
+
+// apply sets the fields of the column given in the input.
+func (in *columnInput) apply(c *domain.Column) {
+	c.Name = in.Name
+	c.Status = in.Status
+	c.Category = ""
+	if in.Category != nil {
+		c.Category = domain.Category(*in.Category)
+	}
+	if in.Position != nil {
+		c.Position = int(*in.Position)
+	}
+	c.WIPLimit = nil
+	if in.WipLimit != nil {
+		limit := int(*in.WipLimit)
+		c.WIPLimit = &limit
+	}
+}
+
+func (r *resolver) CreateColumn(ctx context.Context, args struct {
+	ProjectID gql.ID
+	Input     columnInput
+}) (*columnResolver, error) {
+	projectID, err := parseID(args.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	column := domain.Column{ProjectID: projectID}
+	args.Input.apply(&column)
+	if err = isValid(&column); err != nil {
+		return nil, err
+	}
+	if err = r.projectUsecase.StoreColumn(ctx, &column); err != nil {
+		return nil, err
+	}
+
+	return &columnResolver{column: column}, nil
+}
+
+func (r *resolver) UpdateColumn(ctx context.Context, args struct {
+	ID    gql.ID
+	Input columnInput
+}) (*columnResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	column, err := r.columnUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	args.Input.apply(&column)
+	if err = isValid(&column); err != nil {
+		return nil, err
+	}
+	if err = r.columnUsecase.Update(ctx, &column); err != nil {
+		return nil, err
+	}
+	// Read the column back to resolve the timestamps set by the database.
+	return r.Column(ctx, idArgs{ID: args.ID})
+}
+
+func (r *resolver) DeleteColumn(ctx context.Context, args idArgs) (gql.ID, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err = r.columnUsecase.Delete(ctx, id); err != nil {
+		return "", err
+	}
+
+	return args.ID, nil
+}
+
+type taskInput struct {
+	Name        string
+	Description string
+	ColumnID    gql.ID
+	ParentID    *gql.ID
+	Position    *int32
+}
+
+// apply sets the fields of the task given in the input.
+func (in *taskInput) apply(tk *domain.Task) error {
+	columnID, err := uuid.Parse(string(in.ColumnID))
+	if err != nil {
+		return fmt.Errorf("columnId: %w", domain.ErrBadParamInput)
+	}
+	tk.ParentID = nil
+	if in.ParentID != nil {
+		parentID, err := uuid.Parse(string(*in.ParentID))
+		if err != nil {
+			return fmt.Errorf("parentId: %w", domain.ErrBadParamInput)
+		}
+		tk.ParentID = &parentID
+	}
+	tk.Name = in.Name
+	tk.Description = in.Description
+	tk.ColumnID = columnID
+	if in.Position != nil {
+		tk.Position = int(*in.Position)
+	}
+
+	return isValid(tk)
+}
+
+func (r *resolver) CreateTask(ctx context.Context, args struct{ Input taskInput }) (*taskResolver, error) {
+	// nolint:exhaustivestruct
+	var task domain.Task
+	if err := args.Input.apply(&task); err != nil {
+		return nil, err
+	}
+	if err := r.taskUsecase.Store(ctx, &task); err != nil {
+		return nil, err
+	}
+
+	return &taskResolver{task: task}, nil
+}
+
+func (r *resolver) UpdateTask(ctx context.Context, args struct {
+	ID    gql.ID
+	Input taskInput
+}) (*taskResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	task, err := r.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = args.Input.apply(&task); err != nil {
+		return nil, err
+	}
+	if err = r.taskUsecase.Update(ctx, &task); err != nil {
+		return nil, err
+	}
+	// Read the task back to resolve the timestamps set by the database.
+	return r.Task(ctx, idArgs{ID: args.ID})
+}
+
+func (r *resolver) DeleteTask(ctx context.Context, args idArgs) (gql.ID, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err = r.taskUsecase.Delete(ctx, id); err != nil {
+		return "", err
+	}
+
+	return args.ID, nil
+}
+
+func (r *resolver) CreateComment(ctx context.Context, args struct {
+	TaskID gql.ID
+	Text   string
+}) (*commentResolver, error) {
+	taskID, err := parseID(args.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	comment := domain.Comment{TaskID: taskID, Text: args.Text}
+	if err = isValid(&comment); err != nil {
+		return nil, err
+	}
+	if err = r.taskUsecase.StoreComment(ctx, &comment); err != nil {
+		return nil, err
+	}
+
+	return &commentResolver{comment: comment}, nil
+}
+
+func (r *resolver) UpdateComment(ctx context.Context, args struct {
+	ID   gql.ID
+	Text string
+}) (*commentResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	comment := domain.Comment{ID: id, Text: args.Text}
+	if err = isValid(&comment); err != nil {
+		return nil, err
+	}
+	if err = r.commentUsecase.Update(ctx, &comment); err != nil {
+		return nil, err
+	}
+	// Read the comment back to resolve the task and the timestamps set by the database.
+	return r.Comment(ctx, idArgs{ID: args.ID})
+}
+
+func (r *resolver) DeleteComment(ctx context.Context, args idArgs) (gql.ID, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err = r.commentUsecase.Delete(ctx, id); err != nil {
+		return "", err
+	}
+
+	return args.ID, nil
+}
@@ -0,0 +1,101 @@
+package graphql
+
+// schema mirrors domain.Project, domain.Column, domain.Task and domain.Comment.
+const schema = `
+schema {
+	query: Query
+	mutation: Mutation
+}
+
+scalar Time
+
+type Query {
+	projects: [Project!]!
+	project(id: ID!): Project!
+	column(id: ID!): Column!
+	task(id: ID!): Task!
+	comment(id: ID!): Comment!
+}
+
+type Mutation {
+	createProject(input: ProjectInput!): Project!
+	updateProject(id: ID!, input: ProjectInput!): Project!
+	deleteProject(id: ID!): ID!
+	createColumn(projectId: ID!, input: ColumnInput!): Column!
+	updateColumn(id: ID!, input: ColumnInput!): Column!
+	deleteColumn(id: ID!): ID!
+	createTask(input: TaskInput!): Task!
+	updateTask(id: ID!, input: TaskInput!): Task!
+	deleteTask(id: ID!): ID!
+	createComment(taskId: ID!, text: String!): Comment!
+	updateComment(id: ID!, text: String!): Comment!
+	deleteComment(id: ID!): ID!
+}
+
+type Project {
+	id: ID!
+	name: String!
+	description: String!
+	archivedAt: Time
+	createdAt: Time!
+	updatedAt: Time!
+	columns: [Column!]!
+}
+
+type Column {
+	id: ID!
+	position: Int!
+	name: String!
+	status: String!
+	category: String!
+	wipLimit: Int
+	projectId: ID!
+	createdAt: Time!
+	updatedAt: Time!
+	tasks: [Task!]!
+}
+
+type Task {
+	id: ID!
+	position: Int!
+	name: String!
+	description: String!
+	columnId: ID!
+	parentId: ID
+	completedAt: Time
+	createdAt: Time!
+	updatedAt: Time!
+	comments: [Comment!]!
+}
+
+type Comment {
+	id: ID!
+	text: String!
+	taskId: ID!
+	createdAt: Time!
+	updatedAt: Time!
+}
+
+input ProjectInput {
+	name: String!
+	description: String!
+}
+
+# The position is kept on update when it is not given.
+input ColumnInput {
+	name: String!
+	status: String!
+	category: String
+	position: Int
+	wipLimit: Int
+}
+
+# The position is kept on update when it is not given.
+input TaskInput {
+	name: String!
+	description: String!
+	columnId: ID!
+	parentId: ID
+	position: Int
+}
+`
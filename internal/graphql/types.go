@@ -0,0 +1,112 @@
+package graphql
+
+import (
+	"context"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+type projectResolver struct {
+	project domain.Project
+}
+
+func (r *projectResolver) ID() gql.ID            { return gql.ID(r.project.ID.String()) }
+func (r *projectResolver) Name() string          { return r.project.Name }
+func (r *projectResolver) Description() string   { return r.project.Description }
+func (r *projectResolver) ArchivedAt() *gql.Time { return optionalTime(r.project.ArchivedAt) }
+func (r *projectResolver) CreatedAt() gql.Time   { return gql.Time{Time: r.project.CreatedAt} }
+func (r *projectResolver) UpdatedAt() gql.Time   { return gql.Time{Time: r.project.UpdatedAt} }
+
+func (r *projectResolver) Columns(ctx context.Context) ([]*columnResolver, error) {
+	columns, err := loadersFrom(ctx).columnsOf(ctx, r.project.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*columnResolver, 0, len(columns))
+	for _, c := range columns {
+		result = append(result, &columnResolver{column: c})
+	}
+
+	return result, nil
+}
+
+type columnResolver struct {
+	column domain.Column
+}
+
+func (r *columnResolver) ID() gql.ID          { return gql.ID(r.column.ID.String()) }
+func (r *columnResolver) Position() int32     { return int32(r.column.Position) }
+func (r *columnResolver) Name() string        { return r.column.Name }
+func (r *columnResolver) Status() string      { return r.column.Status }
+func (r *columnResolver) Category() string    { return string(r.column.Category) }
+func (r *columnResolver) ProjectID() gql.ID   { return gql.ID(r.column.ProjectID.String()) }
+func (r *columnResolver) CreatedAt() gql.Time { return gql.Time{Time: r.column.CreatedAt} }
+func (r *columnResolver) UpdatedAt() gql.Time { return gql.Time{Time: r.column.UpdatedAt} }
+
+func (r *columnResolver) WipLimit() *int32 {
+	if r.column.WIPLimit == nil {
+		return nil
+	}
+	limit := int32(*r.column.WIPLimit)
+
+	return &limit
+}
+
+func (r *columnResolver) Tasks(ctx context.Context) ([]*taskResolver, error) {
+	tasks, err := loadersFrom(ctx).tasksOf(ctx, r.column.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*taskResolver, 0, len(tasks))
+	for _, tk := range tasks {
+		result = append(result, &taskResolver{task: tk})
+	}
+
+	return result, nil
+}
+
+type taskResolver struct {
+	task domain.Task
+}
+
+func (r *taskResolver) ID() gql.ID             { return gql.ID(r.task.ID.String()) }
+func (r *taskResolver) Position() int32        { return int32(r.task.Position) }
+func (r *taskResolver) Name() string           { return r.task.Name }
+func (r *taskResolver) Description() string    { return r.task.Description }
+func (r *taskResolver) ColumnID() gql.ID       { return gql.ID(r.task.ColumnID.String()) }
+func (r *taskResolver) CompletedAt() *gql.Time { return optionalTime(r.task.CompletedAt) }
+func (r *taskResolver) CreatedAt() gql.Time    { return gql.Time{Time: r.task.CreatedAt} }
+func (r *taskResolver) UpdatedAt() gql.Time    { return gql.Time{Time: r.task.UpdatedAt} }
+
+func (r *taskResolver) ParentID() *gql.ID {
+	if r.task.ParentID == nil {
+		return nil
+	}
+	id := gql.ID(r.task.ParentID.String())
+
+	return &id
+}
+
+func (r *taskResolver) Comments(ctx context.Context) ([]*commentResolver, error) {
+	comments, err := loadersFrom(ctx).commentsOf(ctx, r.task.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*commentResolver, 0, len(comments))
+	for _, cm := range comments {
+		result = append(result, &commentResolver{comment: cm})
+	}
+
+	return result, nil
+}
+
+type commentResolver struct {
+	comment domain.Comment
+}
+
+func (r *commentResolver) ID() gql.ID          { return gql.ID(r.comment.ID.String()) }
+func (r *commentResolver) Text() string        { return r.comment.Text }
+func (r *commentResolver) TaskID() gql.ID      { return gql.ID(r.comment.TaskID.String()) }
+func (r *commentResolver) CreatedAt() gql.Time { return gql.Time{Time: r.comment.CreatedAt} }
+func (r *commentResolver) UpdatedAt() gql.Time { return gql.Time{Time: r.comment.UpdatedAt} }
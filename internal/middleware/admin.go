@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+var AdminTokenHeader = "X-Admin-Token"
+
+const (
+	AdminKey contextKey = "admin"
+)
+
+// IsAdmin reports whether the request was authenticated with the admin token.
+func IsAdmin(ctx context.Context) bool {
+	admin, ok := ctx.Value(AdminKey).(bool)
+
+	return ok && admin
+}
+
+// Admin marks requests carrying the given admin token as made by an admin.
+// An empty token disables admin requests.
+func Admin(token string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(AdminTokenHeader)
+			if token == "" || header == "" || subtle.ConstantTimeCompare([]byte(header), []byte(token)) != 1 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), AdminKey, true)))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// WIPOverride returns the request context allowing to exceed column WIP limits
+// if an admin asked for it by the override_wip query param.
+func WIPOverride(r *http.Request) (context.Context, error) {
+	if r.URL.Query().Get("override_wip") != "true" {
+		return r.Context(), nil
+	}
+	if !IsAdmin(r.Context()) {
+		return nil, fmt.Errorf("override wip limit: %w", domain.ErrForbidden)
+	}
+
+	return domain.WithWIPOverride(r.Context()), nil
+}
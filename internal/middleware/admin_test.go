@@ -0,0 +1,83 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/middleware"
+)
+
+func TestAdmin(t *testing.T) {
+	tt := []struct {
+		name   string
+		token  string
+		header string
+		want   string
+	}{
+		{"valid token", "secret", "secret", "true"},
+		{"invalid token", "secret", "wrong", "false"},
+		{"missing header", "secret", "", "false"},
+		{"admin disabled", "", "", "false"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			h := middleware.Admin(tc.token)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, middleware.IsAdmin(r.Context()))
+			}))
+			w := httptest.NewRecorder()
+			req, err := http.NewRequestWithContext(context.Background(), "GET", "http://example.com/foo", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.header != "" {
+				req.Header.Set(middleware.AdminTokenHeader, tc.header)
+			}
+			h.ServeHTTP(w, req)
+			if got := w.Body.String(); got != tc.want {
+				t.Errorf("want %s got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWIPOverride(t *testing.T) {
+	tt := []struct {
+		name      string
+		query     string
+		admin     bool
+		want      bool
+		forbidden bool
+	}{
+		{"not asked", "", true, false, false},
+		{"asked by admin", "?override_wip=true", true, true, false},
+		{"asked by user", "?override_wip=true", false, false, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), middleware.AdminKey, tc.admin)
+			req, err := http.NewRequestWithContext(ctx, "DELETE", "http://example.com/foo"+tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := middleware.WIPOverride(req)
+			if tc.forbidden {
+				if !errors.Is(err, domain.ErrForbidden) {
+					t.Errorf("want %v got %v", domain.ErrForbidden, err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if domain.IsWIPOverride(got) != tc.want {
+				t.Errorf("want %t got %t", tc.want, domain.IsWIPOverride(got))
+			}
+		})
+	}
+}
@@ -44,7 +44,7 @@ func TestRequestID(t *testing.T) {
 		got := w.Body.String()
 		_, err = uuid.Parse(got)
 		if err != nil {
-			t.Errorf("got %s invvalid uuid %w", got, err)
+			t.Errorf("got %s invvalid uuid %v", got, err)
 		}
 	})
 }
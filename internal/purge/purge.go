@@ -0,0 +1,43 @@
+// Package purge removes the soft deleted items older than the retention period.
+package purge
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Purger is implemented by the repositories keeping soft deleted items.
+type Purger interface {
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Run purges the items deleted longer than retention ago every interval until ctx is done.
+func Run(ctx context.Context, logger *zap.Logger, interval, retention time.Duration, purgers ...Purger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		Once(ctx, logger, time.Now().UTC().Add(-retention), purgers...)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Once purges the items deleted before the time, a failing purger does not stop the others.
+func Once(ctx context.Context, logger *zap.Logger, before time.Time, purgers ...Purger) {
+	for _, p := range purgers {
+		n, err := p.Purge(ctx, before)
+		if err != nil {
+			logger.Error("purge: purging deleted items", zap.Error(err))
+
+			continue
+		}
+		if n > 0 {
+			logger.Info("purge: purged deleted items", zap.Int64("count", n), zap.Time("before", before))
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package purge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/tasktracker/internal/purge"
+	helper "github.com/matryer/is"
+	"go.uber.org/zap"
+)
+
+type purgerFunc func(ctx context.Context, before time.Time) (int64, error)
+
+func (f purgerFunc) Purge(ctx context.Context, before time.Time) (int64, error) {
+	return f(ctx, before)
+}
+
+func TestOnce(t *testing.T) {
+	is := helper.New(t)
+
+	before := time.Now().UTC()
+	var calls []time.Time
+	ok := purgerFunc(func(ctx context.Context, b time.Time) (int64, error) {
+		calls = append(calls, b)
+
+		return 1, nil
+	})
+	failing := purgerFunc(func(ctx context.Context, b time.Time) (int64, error) {
+		return 0, errors.New("some error")
+	})
+	purge.Once(context.TODO(), zap.NewNop(), before, failing, ok)
+	is.Equal(calls, []time.Time{before})
+}
+
+func TestRun(t *testing.T) {
+	is := helper.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	retention := time.Hour
+	var got time.Time
+	p := purgerFunc(func(ctx context.Context, before time.Time) (int64, error) {
+		got = before
+		cancel()
+
+		return 0, nil
+	})
+	start := time.Now().UTC()
+	purge.Run(ctx, zap.NewNop(), time.Hour, retention, p)
+	is.True(!got.Before(start.Add(-retention)))
+	is.True(got.Before(time.Now().UTC().Add(-retention)))
+}
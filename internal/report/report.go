@@ -0,0 +1,166 @@
+// Package report renders the board reports of projects with Go templates.
+package report
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	texttemplate "text/template"
+
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// The formats of a report.
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// The names of the files in the templates directory overriding the built-in templates.
+const (
+	MarkdownFile = "board.md.tmpl"
+	HTMLFile     = "board.html.tmpl"
+)
+
+const markdownTemplate = `# {{.Project.Name}}
+{{with .Project.Description}}
+{{.}}
+{{end}}
+{{- range .Columns}}
+## {{.Column.Name}} ({{len .Tasks}})
+{{range .Tasks}}
+### {{.Task.Name}}
+{{with .Task.Description}}
+{{.}}
+{{end}}
+{{- if .Comments}}
+Recent comments:
+{{range .Comments}}
+> {{.CreatedAt.Format "2006-01-02 15:04"}}: {{.Text}}
+{{end}}
+{{- end}}
+{{- else}}
+_No tasks_
+{{end}}
+{{- end}}
+_Generated {{.GeneratedAt.Format "2006-01-02 15:04 MST"}}_
+`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Project.Name}}</title></head>
+<body>
+<h1>{{.Project.Name}}</h1>
+{{with .Project.Description}}<p>{{.}}</p>{{end}}
+{{- range .Columns}}
+<section>
+<h2>{{.Column.Name}} ({{len .Tasks}})</h2>
+{{- range .Tasks}}
+<article>
+<h3>{{.Task.Name}}</h3>
+{{with .Task.Description}}<p>{{.}}</p>{{end}}
+{{- if .Comments}}
+<ul>
+{{- range .Comments}}
+<li><time>{{.CreatedAt.Format "2006-01-02 15:04"}}</time> {{.Text}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</article>
+{{- else}}
+<p><em>No tasks</em></p>
+{{- end}}
+</section>
+{{- end}}
+<footer>Generated {{.GeneratedAt.Format "2006-01-02 15:04 MST"}}</footer>
+</body>
+</html>
+`
+
+// executor is implemented by both text and html templates.
+type executor interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// Renderer renders the reports in the supported formats.
+type Renderer struct {
+	templates map[string]executor
+}
+
+// New will create new a Renderer with the built-in templates overridden by
+// the templates found in dir, an empty dir keeps the built-in ones.
+func New(dir string) (*Renderer, error) {
+	markdown, err := load(dir, MarkdownFile, markdownTemplate)
+	if err != nil {
+		return nil, err
+	}
+	md, err := texttemplate.New(MarkdownFile).Parse(markdown)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", MarkdownFile, err)
+	}
+	html, err := load(dir, HTMLFile, htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	ht, err := htmltemplate.New(HTMLFile).Parse(html)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", HTMLFile, err)
+	}
+
+	return &Renderer{templates: map[string]executor{FormatMarkdown: md, FormatHTML: ht}}, nil
+}
+
+// Default returns a Renderer with the built-in templates.
+func Default() *Renderer {
+	r, err := New("")
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// load returns the text of the template file in dir or the built-in text when there is no such file.
+func load(dir, name, builtin string) (string, error) {
+	if dir == "" {
+		return builtin, nil
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return builtin, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", name, err)
+	}
+
+	return string(b), nil
+}
+
+// ContentType returns the content type of the format and whether the format is supported.
+func ContentType(format string) (string, bool) {
+	switch format {
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8", true
+	case FormatHTML:
+		return "text/html; charset=utf-8", true
+	default:
+		return "", false
+	}
+}
+
+// Render writes the report in the format to w.
+func (r *Renderer) Render(w io.Writer, format string, rp *domain.Report) error {
+	t, ok := r.templates[format]
+	if !ok {
+		return fmt.Errorf("report format %q: %w", format, domain.ErrBadParamInput)
+	}
+	if err := t.Execute(w, rp); err != nil {
+		return fmt.Errorf("render report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package report_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/report"
+	helper "github.com/matryer/is"
+)
+
+//nolint:exhaustivestruct
+func testReport() *domain.Report {
+	return &domain.Report{
+		Project: domain.Project{Name: "Roadmap", Description: "Product roadmap"},
+		Columns: []domain.ReportColumn{
+			{
+				Column: domain.Column{Name: "Todo"},
+				Tasks: []domain.ReportTask{
+					{
+						Task:     domain.Task{Name: "First", Description: "Details <b>bold</b>"},
+						Comments: []domain.Comment{{Text: "looks good", CreatedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)}},
+					},
+					{Task: domain.Task{Name: "Second"}},
+				},
+			},
+			{Column: domain.Column{Name: "Done"}},
+		},
+		GeneratedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderDefault(t *testing.T) {
+	is := helper.New(t)
+
+	r := report.Default()
+	var buf bytes.Buffer
+	is.NoErr(r.Render(&buf, report.FormatMarkdown, testReport()))
+	md := buf.String()
+	is.True(strings.HasPrefix(md, "# Roadmap\n"))
+	is.True(strings.Index(md, "## Todo (2)") < strings.Index(md, "## Done (0)"))
+	is.True(strings.Index(md, "### First") < strings.Index(md, "### Second"))
+	is.True(strings.Contains(md, "> 2026-10-01 12:00: looks good"))
+	is.True(strings.Contains(md, "_No tasks_"))
+
+	buf.Reset()
+	is.NoErr(r.Render(&buf, report.FormatHTML, testReport()))
+	html := buf.String()
+	is.True(strings.Contains(html, "<h2>Todo (2)</h2>"))
+	is.True(strings.Contains(html, "Details &lt;b&gt;bold&lt;/b&gt;"))
+
+	err := r.Render(&buf, "pdf", testReport())
+	is.True(errors.Is(err, domain.ErrBadParamInput))
+}
+
+func TestRenderOverride(t *testing.T) {
+	is := helper.New(t)
+
+	dir := t.TempDir()
+	tmpl := "{{.Project.Name}}:{{range .Columns}} {{.Column.Name}}{{end}}"
+	is.NoErr(ioutil.WriteFile(filepath.Join(dir, report.MarkdownFile), []byte(tmpl), 0o600))
+	r, err := report.New(dir)
+	is.NoErr(err)
+	var buf bytes.Buffer
+	is.NoErr(r.Render(&buf, report.FormatMarkdown, testReport()))
+	is.Equal(buf.String(), "Roadmap: Todo Done")
+
+	buf.Reset()
+	is.NoErr(r.Render(&buf, report.FormatHTML, testReport()))
+	is.True(strings.Contains(buf.String(), "<h1>Roadmap</h1>"))
+
+	is.NoErr(ioutil.WriteFile(filepath.Join(dir, report.HTMLFile), []byte("{{.Project.Name"), 0o600))
+	_, err = report.New(dir)
+	is.True(err != nil)
+}
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+)
+
+type columnServer struct {
+	trackerv1.UnimplementedColumnServiceServer
+	columnUsecase domain.ColumnUsecase
+}
+
+func (s *columnServer) GetColumn(ctx context.Context, req *trackerv1.GetColumnRequest) (*trackerv1.Column, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	column, err := s.columnUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toColumn(&column), nil
+}
+
+func (s *columnServer) UpdateColumn(
+	ctx context.Context,
+	req *trackerv1.UpdateColumnRequest,
+) (*trackerv1.Column, error) {
+	id, err := parseID(req.GetColumn().GetId())
+	if err != nil {
+		return nil, err
+	}
+	column := fromColumn(req.GetColumn())
+	column.ID = id
+	if err = isValid(&column); err != nil {
+		return nil, err
+	}
+	if err = s.columnUsecase.Update(ctx, &column); err != nil {
+		return nil, err
+	}
+	// Read the column back to respond with the timestamps set by the database.
+	column, err = s.columnUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toColumn(&column), nil
+}
+
+func (s *columnServer) DeleteColumn(
+	ctx context.Context,
+	req *trackerv1.DeleteColumnRequest,
+) (*trackerv1.DeleteColumnResponse, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.columnUsecase.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &trackerv1.DeleteColumnResponse{}, nil
+}
+
+func (s *columnServer) ListColumnTasks(
+	ctx context.Context,
+	req *trackerv1.ListColumnTasksRequest,
+) (*trackerv1.ListColumnTasksResponse, error) {
+	id, err := parseID(req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.columnUsecase.FetchTasks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:exhaustivestruct
+	return &trackerv1.ListColumnTasksResponse{Tasks: toTasks(tasks)}, nil
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+)
+
+type commentServer struct {
+	trackerv1.UnimplementedCommentServiceServer
+	commentUsecase domain.CommentUsecase
+}
+
+func (s *commentServer) GetComment(ctx context.Context, req *trackerv1.GetCommentRequest) (*trackerv1.Comment, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.commentUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toComment(&comment), nil
+}
+
+func (s *commentServer) UpdateComment(
+	ctx context.Context,
+	req *trackerv1.UpdateCommentRequest,
+) (*trackerv1.Comment, error) {
+	id, err := parseID(req.GetComment().GetId())
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	comment := domain.Comment{ID: id, Text: req.GetComment().GetText()}
+	if err = isValid(&comment); err != nil {
+		return nil, err
+	}
+	if err = s.commentUsecase.Update(ctx, &comment); err != nil {
+		return nil, err
+	}
+	// Read the comment back to respond with the task and the timestamps set by the database.
+	comment, err = s.commentUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toComment(&comment), nil
+}
+
+func (s *commentServer) DeleteComment(
+	ctx context.Context,
+	req *trackerv1.DeleteCommentRequest,
+) (*trackerv1.DeleteCommentResponse, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.commentUsecase.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &trackerv1.DeleteCommentResponse{}, nil
+}
@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func toProject(p *domain.Project) *trackerv1.Project {
+	// nolint:exhaustivestruct
+	return &trackerv1.Project{
+		Id:          p.ID.String(),
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		ArchivedAt:  optionalTimestamp(p.ArchivedAt),
+	}
+}
+
+// fromProject returns the writable fields of the project.
+func fromProject(p *trackerv1.Project) domain.Project {
+	// nolint:exhaustivestruct
+	return domain.Project{Name: p.GetName(), Description: p.GetDescription()}
+}
+
+func toColumn(c *domain.Column) *trackerv1.Column {
+	// nolint:exhaustivestruct
+	column := &trackerv1.Column{
+		Id:        c.ID.String(),
+		Position:  int32(c.Position),
+		Name:      c.Name,
+		Status:    c.Status,
+		Category:  string(c.Category),
+		ProjectId: c.ProjectID.String(),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+	if c.WIPLimit != nil {
+		column.WipLimit = wrapperspb.Int32(int32(*c.WIPLimit))
+	}
+
+	return column
+}
+
+// fromColumn returns the writable fields of the column.
+func fromColumn(c *trackerv1.Column) domain.Column {
+	// nolint:exhaustivestruct
+	column := domain.Column{
+		Position: int(c.GetPosition()),
+		Name:     c.GetName(),
+		Status:   c.GetStatus(),
+		Category: domain.Category(c.GetCategory()),
+	}
+	if c.GetWipLimit() != nil {
+		limit := int(c.GetWipLimit().GetValue())
+		column.WIPLimit = &limit
+	}
+
+	return column
+}
+
+func toTask(t *domain.Task) *trackerv1.Task {
+	// nolint:exhaustivestruct
+	task := &trackerv1.Task{
+		Id:          t.ID.String(),
+		Position:    int32(t.Position),
+		Name:        t.Name,
+		Description: t.Description,
+		ColumnId:    t.ColumnID.String(),
+		CompletedAt: optionalTimestamp(t.CompletedAt),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+	if t.ParentID != nil {
+		task.ParentId = t.ParentID.String()
+	}
+
+	return task
+}
+
+// fromTask returns the writable fields of the task.
+func fromTask(t *trackerv1.Task) (domain.Task, error) {
+	columnID, err := uuid.Parse(t.GetColumnId())
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("column_id: %w", domain.ErrBadParamInput)
+	}
+	// nolint:exhaustivestruct
+	task := domain.Task{
+		Position:    int(t.GetPosition()),
+		Name:        t.GetName(),
+		Description: t.GetDescription(),
+		ColumnID:    columnID,
+	}
+	if t.GetParentId() != "" {
+		parentID, err := uuid.Parse(t.GetParentId())
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("parent_id: %w", domain.ErrBadParamInput)
+		}
+		task.ParentID = &parentID
+	}
+
+	return task, nil
+}
+
+func toComment(c *domain.Comment) *trackerv1.Comment {
+	// nolint:exhaustivestruct
+	return &trackerv1.Comment{
+		Id:        c.ID.String(),
+		Text:      c.Text,
+		TaskId:    c.TaskID.String(),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func toColumns(cls []domain.Column) []*trackerv1.Column {
+	result := make([]*trackerv1.Column, 0, len(cls))
+	for n := range cls {
+		result = append(result, toColumn(&cls[n]))
+	}
+
+	return result
+}
+
+func toTasks(tks []domain.Task) []*trackerv1.Task {
+	result := make([]*trackerv1.Task, 0, len(tks))
+	for n := range tks {
+		result = append(result, toTask(&tks[n]))
+	}
+
+	return result
+}
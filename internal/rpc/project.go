@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+)
+
+type projectServer struct {
+	trackerv1.UnimplementedProjectServiceServer
+	projectUsecase domain.ProjectUsecase
+}
+
+func (s *projectServer) ListProjects(
+	ctx context.Context,
+	_ *trackerv1.ListProjectsRequest,
+) (*trackerv1.ListProjectsResponse, error) {
+	// nolint:exhaustivestruct
+	projects, err := s.projectUsecase.Fetch(ctx, domain.Filter{})
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	resp := &trackerv1.ListProjectsResponse{Projects: make([]*trackerv1.Project, 0, len(projects))}
+	for n := range projects {
+		resp.Projects = append(resp.Projects, toProject(&projects[n]))
+	}
+
+	return resp, nil
+}
+
+func (s *projectServer) GetProject(ctx context.Context, req *trackerv1.GetProjectRequest) (*trackerv1.Project, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProject(&project), nil
+}
+
+func (s *projectServer) CreateProject(
+	ctx context.Context,
+	req *trackerv1.CreateProjectRequest,
+) (*trackerv1.Project, error) {
+	project := fromProject(req.GetProject())
+	if err := isValid(&project); err != nil {
+		return nil, err
+	}
+	if err := s.projectUsecase.Store(ctx, &project); err != nil {
+		return nil, err
+	}
+
+	return toProject(&project), nil
+}
+
+func (s *projectServer) UpdateProject(
+	ctx context.Context,
+	req *trackerv1.UpdateProjectRequest,
+) (*trackerv1.Project, error) {
+	id, err := parseID(req.GetProject().GetId())
+	if err != nil {
+		return nil, err
+	}
+	project := fromProject(req.GetProject())
+	project.ID = id
+	if err = isValid(&project); err != nil {
+		return nil, err
+	}
+	if err = s.projectUsecase.Update(ctx, &project); err != nil {
+		return nil, err
+	}
+	// Read the project back to respond with the timestamps set by the database.
+	project, err = s.projectUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProject(&project), nil
+}
+
+func (s *projectServer) DeleteProject(
+	ctx context.Context,
+	req *trackerv1.DeleteProjectRequest,
+) (*trackerv1.DeleteProjectResponse, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.projectUsecase.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &trackerv1.DeleteProjectResponse{}, nil
+}
+
+func (s *projectServer) ListProjectColumns(
+	ctx context.Context,
+	req *trackerv1.ListProjectColumnsRequest,
+) (*trackerv1.ListProjectColumnsResponse, error) {
+	id, err := parseID(req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	columns, err := s.projectUsecase.FetchColumns(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:exhaustivestruct
+	return &trackerv1.ListProjectColumnsResponse{Columns: toColumns(columns)}, nil
+}
+
+func (s *projectServer) CreateColumn(
+	ctx context.Context,
+	req *trackerv1.CreateColumnRequest,
+) (*trackerv1.Column, error) {
+	projectID, err := parseID(req.GetColumn().GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	column := fromColumn(req.GetColumn())
+	column.ProjectID = projectID
+	if err = isValid(&column); err != nil {
+		return nil, err
+	}
+	if err = s.projectUsecase.StoreColumn(ctx, &column); err != nil {
+		return nil, err
+	}
+
+	return toColumn(&column), nil
+}
+
+func (s *projectServer) ListProjectTasks(
+	ctx context.Context,
+	req *trackerv1.ListProjectTasksRequest,
+) (*trackerv1.ListProjectTasksResponse, error) {
+	id, err := parseID(req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.projectUsecase.FetchTasks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:exhaustivestruct
+	return &trackerv1.ListProjectTasksResponse{Tasks: toTasks(tasks)}, nil
+}
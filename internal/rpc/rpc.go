@@ -0,0 +1,111 @@
+// Package rpc serves the tasktracker gRPC services on top of the domain usecases.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/web"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// New constructs a grpc.Server with the project, column, task and comment services.
+func New(
+	logger *zap.Logger,
+	p domain.ProjectUsecase,
+	c domain.ColumnUsecase,
+	t domain.TaskUsecase,
+	cm domain.CommentUsecase,
+) *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(errorInterceptor(logger)))
+	trackerv1.RegisterProjectServiceServer(s, &projectServer{projectUsecase: p})
+	trackerv1.RegisterColumnServiceServer(s, &columnServer{columnUsecase: c})
+	trackerv1.RegisterTaskServiceServer(s, &taskServer{taskUsecase: t})
+	trackerv1.RegisterCommentServiceServer(s, &commentServer{commentUsecase: cm})
+
+	return s
+}
+
+// errorInterceptor converts the errors of the handlers to statuses,
+// the internal errors are logged and their details are hidden from clients.
+func errorInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		st := getStatus(err)
+		if st.Code() == codes.Internal {
+			logger.Error("rpc: "+info.FullMethod, zap.Error(err))
+		}
+
+		return nil, st.Err()
+	}
+}
+
+// statusCodes are the codes of the HTTP statuses of the registered errors.
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest: codes.InvalidArgument,
+	http.StatusForbidden:  codes.PermissionDenied,
+	http.StatusNotFound:   codes.NotFound,
+	http.StatusConflict:   codes.FailedPrecondition,
+}
+
+// getStatus returns the status of the error. The registered errors get the code of their HTTP status
+// and their public message from the web error registry, so both transports answer the same.
+func getStatus(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+	if fes := web.FieldErrors(err); fes != nil {
+		return status.New(codes.InvalidArgument, web.ValidationDetail(fes))
+	}
+	spec, ok := web.LookupError(err)
+	if !ok || spec.Status >= http.StatusInternalServerError {
+		return status.New(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+	code, ok := statusCodes[spec.Status]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	return status.New(code, spec.Message)
+}
+
+// parseID returns the uuid of the id, the ids that are not uuids are not found.
+func parseID(id string) (uuid.UUID, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, domain.ErrNotFound
+	}
+
+	return u, nil
+}
+
+func isValid(m interface{}) error {
+	if err := validator.New().Struct(m); err != nil {
+		return fmt.Errorf("validation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	mocks "github.com/igkostyuk/tasktracker/domain/mock"
+	"github.com/igkostyuk/tasktracker/internal/rpc"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+	helper "github.com/matryer/is"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dial serves the usecases on an in-memory listener and returns a connection to them.
+func dial(
+	t *testing.T,
+	p domain.ProjectUsecase,
+	c domain.ColumnUsecase,
+	tk domain.TaskUsecase,
+	cm domain.CommentUsecase,
+) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	s := rpc.New(zap.NewNop(), p, c, tk, cm)
+	go func() { _ = s.Serve(listener) }()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+//nolint:exhaustivestruct
+func TestProjectService(t *testing.T) {
+	is := helper.New(t)
+
+	projectID, columnID := uuid.New(), uuid.New()
+	limit := 2
+	mp := &mocks.ProjectUsecaseMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			if id != projectID {
+				return domain.Project{}, domain.ErrNotFound
+			}
+
+			return domain.Project{ID: id, Name: "board"}, nil
+		},
+		FetchColumnsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: columnID, Name: "todo", ProjectID: id, WIPLimit: &limit}}, nil
+		},
+	}
+	conn := dial(t, mp, &mocks.ColumnUsecaseMock{}, &mocks.TaskUsecaseMock{}, &mocks.CommentUsecaseMock{})
+	client := trackerv1.NewProjectServiceClient(conn)
+	ctx := context.Background()
+
+	project, err := client.GetProject(ctx, &trackerv1.GetProjectRequest{Id: projectID.String()})
+	is.NoErr(err)
+	is.Equal(project.GetName(), "board")
+
+	_, err = client.GetProject(ctx, &trackerv1.GetProjectRequest{Id: uuid.New().String()})
+	is.Equal(status.Code(err), codes.NotFound)
+	_, err = client.GetProject(ctx, &trackerv1.GetProjectRequest{Id: "not-an-id"})
+	is.Equal(status.Code(err), codes.NotFound)
+
+	columns, err := client.ListProjectColumns(ctx, &trackerv1.ListProjectColumnsRequest{ProjectId: projectID.String()})
+	is.NoErr(err)
+	is.Equal(len(columns.GetColumns()), 1)
+	is.Equal(columns.GetColumns()[0].GetId(), columnID.String())
+	is.Equal(columns.GetColumns()[0].GetWipLimit().GetValue(), int32(2))
+
+	_, err = client.CreateProject(ctx, &trackerv1.CreateProjectRequest{Project: &trackerv1.Project{}})
+	is.Equal(status.Code(err), codes.InvalidArgument)
+	is.Equal(len(mp.StoreCalls()), 0)
+}
+
+//nolint:exhaustivestruct
+func TestTaskServiceErrors(t *testing.T) {
+	is := helper.New(t)
+
+	mt := &mocks.TaskUsecaseMock{
+		StoreFunc: func(ctx context.Context, tk *domain.Task) error {
+			return domain.ErrWIPLimit
+		},
+		UpdateFunc: func(ctx context.Context, tk *domain.Task) error {
+			return fmt.Errorf("update: %w", domain.ErrParentCycle)
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			return errors.New("connection refused")
+		},
+	}
+	conn := dial(t, &mocks.ProjectUsecaseMock{}, &mocks.ColumnUsecaseMock{}, mt, &mocks.CommentUsecaseMock{})
+	client := trackerv1.NewTaskServiceClient(conn)
+	ctx := context.Background()
+
+	_, err := client.CreateTask(ctx, &trackerv1.CreateTaskRequest{Task: &trackerv1.Task{
+		Name: "task", Description: "text", ColumnId: uuid.New().String(),
+	}})
+	is.Equal(status.Code(err), codes.FailedPrecondition)
+	is.Equal(status.Convert(err).Message(), "Work in progress limit reached")
+
+	_, err = client.UpdateTask(ctx, &trackerv1.UpdateTaskRequest{Task: &trackerv1.Task{
+		Id: uuid.New().String(), Name: "task", Description: "text", ColumnId: uuid.New().String(),
+	}})
+	is.Equal(status.Code(err), codes.FailedPrecondition)
+	is.Equal(status.Convert(err).Message(), "Task cannot be its own ancestor")
+
+	_, err = client.CreateTask(ctx, &trackerv1.CreateTaskRequest{Task: &trackerv1.Task{
+		Name: "task", Description: "text", ColumnId: "column",
+	}})
+	is.Equal(status.Code(err), codes.InvalidArgument)
+
+	_, err = client.DeleteTask(ctx, &trackerv1.DeleteTaskRequest{Id: uuid.New().String()})
+	is.Equal(status.Code(err), codes.Internal)
+	is.Equal(status.Convert(err).Message(), domain.ErrInternalServerError.Error())
+}
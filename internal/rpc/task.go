@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	trackerv1 "github.com/igkostyuk/tasktracker/proto/tasktracker/v1"
+)
+
+type taskServer struct {
+	trackerv1.UnimplementedTaskServiceServer
+	taskUsecase domain.TaskUsecase
+}
+
+func (s *taskServer) GetTask(ctx context.Context, req *trackerv1.GetTaskRequest) (*trackerv1.Task, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTask(&task), nil
+}
+
+func (s *taskServer) CreateTask(ctx context.Context, req *trackerv1.CreateTaskRequest) (*trackerv1.Task, error) {
+	task, err := fromTask(req.GetTask())
+	if err != nil {
+		return nil, err
+	}
+	if err = isValid(&task); err != nil {
+		return nil, err
+	}
+	if err = s.taskUsecase.Store(ctx, &task); err != nil {
+		return nil, err
+	}
+
+	return toTask(&task), nil
+}
+
+func (s *taskServer) UpdateTask(ctx context.Context, req *trackerv1.UpdateTaskRequest) (*trackerv1.Task, error) {
+	id, err := parseID(req.GetTask().GetId())
+	if err != nil {
+		return nil, err
+	}
+	task, err := fromTask(req.GetTask())
+	if err != nil {
+		return nil, err
+	}
+	task.ID = id
+	if err = isValid(&task); err != nil {
+		return nil, err
+	}
+	if err = s.taskUsecase.Update(ctx, &task); err != nil {
+		return nil, err
+	}
+	// Read the task back to respond with the timestamps set by the database.
+	task, err = s.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTask(&task), nil
+}
+
+func (s *taskServer) DeleteTask(
+	ctx context.Context,
+	req *trackerv1.DeleteTaskRequest,
+) (*trackerv1.DeleteTaskResponse, error) {
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.taskUsecase.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &trackerv1.DeleteTaskResponse{}, nil
+}
+
+func (s *taskServer) ListTaskComments(
+	ctx context.Context,
+	req *trackerv1.ListTaskCommentsRequest,
+) (*trackerv1.ListTaskCommentsResponse, error) {
+	id, err := parseID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.taskUsecase.FetchComments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	resp := &trackerv1.ListTaskCommentsResponse{Comments: make([]*trackerv1.Comment, 0, len(comments))}
+	for n := range comments {
+		resp.Comments = append(resp.Comments, toComment(&comments[n]))
+	}
+
+	return resp, nil
+}
+
+func (s *taskServer) CreateComment(
+	ctx context.Context,
+	req *trackerv1.CreateCommentRequest,
+) (*trackerv1.Comment, error) {
+	taskID, err := parseID(req.GetComment().GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+	// nolint:exhaustivestruct
+	comment := domain.Comment{TaskID: taskID, Text: req.GetComment().GetText()}
+	if err = isValid(&comment); err != nil {
+		return nil, err
+	}
+	if err = s.taskUsecase.StoreComment(ctx, &comment); err != nil {
+		return nil, err
+	}
+
+	return toComment(&comment), nil
+}
@@ -0,0 +1,331 @@
+// Package trello imports the boards exported from Trello as JSON.
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// Board represent the parts of a Trello board export used by the import.
+type Board struct {
+	Name       string      `json:"name"`
+	Desc       string      `json:"desc"`
+	Lists      []List      `json:"lists"`
+	Cards      []Card      `json:"cards"`
+	Checklists []Checklist `json:"checklists"`
+	Actions    []Action    `json:"actions"`
+}
+
+// List represent a Trello list.
+type List struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+// Card represent a Trello card.
+type Card struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Desc   string  `json:"desc"`
+	Closed bool    `json:"closed"`
+	IDList string  `json:"idList"`
+	Pos    float64 `json:"pos"`
+	Labels []Label `json:"labels"`
+}
+
+// Label represent a Trello label of a card.
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Checklist represent a Trello checklist of a card.
+type Checklist struct {
+	IDCard     string      `json:"idCard"`
+	Name       string      `json:"name"`
+	Pos        float64     `json:"pos"`
+	CheckItems []CheckItem `json:"checkItems"`
+}
+
+// CheckItem represent an item of a Trello checklist.
+type CheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+// Action represent a Trello action, only the comments of the cards are imported.
+type Action struct {
+	Type string    `json:"type"`
+	Date time.Time `json:"date"`
+	Data struct {
+		Text string `json:"text"`
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	} `json:"data"`
+	MemberCreator struct {
+		FullName string `json:"fullName"`
+	} `json:"memberCreator"`
+}
+
+// Parse reads a Trello board export.
+func Parse(r io.Reader) (Board, error) {
+	var b Board
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return Board{}, fmt.Errorf("decode trello board: %w", err)
+	}
+
+	return b, nil
+}
+
+// Plan represent the project, columns, tasks and comments created by an import.
+type Plan struct {
+	Project domain.Project
+	Columns []PlannedColumn
+	// Skipped is the number of the archived lists and cards left out of the import.
+	Skipped int
+}
+
+// PlannedColumn represent a column created from a Trello list with its tasks in list order.
+type PlannedColumn struct {
+	Name  string
+	Tasks []PlannedTask
+}
+
+// PlannedTask represent a task created from a Trello card with its comments in time order.
+type PlannedTask struct {
+	Name        string
+	Description string
+	Comments    []string
+}
+
+// Tasks returns the number of the planned tasks.
+func (p *Plan) Tasks() int {
+	n := 0
+	for _, c := range p.Columns {
+		n += len(c.Tasks)
+	}
+
+	return n
+}
+
+// Comments returns the number of the planned comments.
+func (p *Plan) Comments() int {
+	n := 0
+	for _, c := range p.Columns {
+		for _, tk := range c.Tasks {
+			n += len(tk.Comments)
+		}
+	}
+
+	return n
+}
+
+// NewPlan maps the open lists of the board to columns and their open cards to tasks.
+// The tracker has neither labels nor checklists, so they are appended to the task description.
+func NewPlan(b *Board) Plan {
+	// nolint:exhaustivestruct
+	plan := Plan{Project: domain.Project{
+		Name:        domain.Truncate(b.Name, domain.MaxProjectName),
+		Description: domain.Truncate(b.Desc, domain.MaxProjectDescription),
+	}}
+	if strings.TrimSpace(plan.Project.Name) == "" {
+		plan.Project.Name = "Trello board"
+	}
+	lists := make([]List, 0, len(b.Lists))
+	for _, l := range b.Lists {
+		if l.Closed {
+			plan.Skipped++
+
+			continue
+		}
+		lists = append(lists, l)
+	}
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Pos < lists[j].Pos })
+
+	cards := make(map[string][]Card)
+	for _, c := range b.Cards {
+		cards[c.IDList] = append(cards[c.IDList], c)
+	}
+	checklists := make(map[string][]Checklist)
+	for _, c := range b.Checklists {
+		checklists[c.IDCard] = append(checklists[c.IDCard], c)
+	}
+	comments := make(map[string][]Action)
+	for _, a := range b.Actions {
+		if a.Type == "commentCard" {
+			comments[a.Data.Card.ID] = append(comments[a.Data.Card.ID], a)
+		}
+	}
+
+	names := make(map[string]bool, len(lists))
+	for _, l := range lists {
+		column := PlannedColumn{Name: uniqueName(l.Name, domain.MaxColumnName, names), Tasks: nil}
+		listCards := cards[l.ID]
+		sort.SliceStable(listCards, func(i, j int) bool { return listCards[i].Pos < listCards[j].Pos })
+		for k := range listCards {
+			c := &listCards[k]
+			if c.Closed {
+				plan.Skipped++
+
+				continue
+			}
+			column.Tasks = append(column.Tasks, plannedTask(c, checklists[c.ID], comments[c.ID]))
+		}
+		plan.Columns = append(plan.Columns, column)
+	}
+	for id, listCards := range cards {
+		if !isOpenList(id, lists) {
+			plan.Skipped += len(listCards)
+		}
+	}
+
+	return plan
+}
+
+func isOpenList(id string, lists []List) bool {
+	for _, l := range lists {
+		if l.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func plannedTask(c *Card, checklists []Checklist, comments []Action) PlannedTask {
+	var desc strings.Builder
+	desc.WriteString(c.Desc)
+	if len(c.Labels) > 0 {
+		labels := make([]string, 0, len(c.Labels))
+		for _, l := range c.Labels {
+			if l.Name == "" {
+				labels = append(labels, l.Color)
+
+				continue
+			}
+			labels = append(labels, l.Name)
+		}
+		fmt.Fprintf(&desc, "\n\nLabels: %s", strings.Join(labels, ", "))
+	}
+	sort.SliceStable(checklists, func(i, j int) bool { return checklists[i].Pos < checklists[j].Pos })
+	for _, cl := range checklists {
+		fmt.Fprintf(&desc, "\n\n%s:", cl.Name)
+		sort.SliceStable(cl.CheckItems, func(i, j int) bool { return cl.CheckItems[i].Pos < cl.CheckItems[j].Pos })
+		for _, item := range cl.CheckItems {
+			mark := " "
+			if item.State == "complete" {
+				mark = "x"
+			}
+			fmt.Fprintf(&desc, "\n- [%s] %s", mark, item.Name)
+		}
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].Date.Before(comments[j].Date) })
+	texts := make([]string, 0, len(comments))
+	for _, a := range comments {
+		text := fmt.Sprintf("%s on %s: %s", a.MemberCreator.FullName, a.Date.UTC().Format(time.RFC3339), a.Data.Text)
+		texts = append(texts, domain.Truncate(text, domain.MaxCommentText))
+	}
+	name := domain.Truncate(c.Name, domain.MaxTaskName)
+	if strings.TrimSpace(name) == "" {
+		name = "Untitled"
+	}
+
+	return PlannedTask{
+		Name:        name,
+		Description: domain.Truncate(strings.TrimSpace(desc.String()), domain.MaxTaskDescription),
+		Comments:    texts,
+	}
+}
+
+// uniqueName returns the name cut to n runes, numbered when it is already taken, since column names
+// are unique on a board. The name is cut before the number so that the numbered name fits in n runes too.
+func uniqueName(name string, n int, taken map[string]bool) string {
+	if strings.TrimSpace(name) == "" {
+		name = "Untitled"
+	}
+	unique := domain.Truncate(name, n)
+	for i := 2; taken[unique]; i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		unique = domain.Truncate(name, n-len(suffix)) + suffix
+	}
+	taken[unique] = true
+
+	return unique
+}
+
+// Importer creates the planned project through the usecases.
+type Importer struct {
+	projects domain.ProjectUsecase
+	columns  domain.ColumnUsecase
+	tasks    domain.TaskUsecase
+}
+
+// New will create new an Importer with the usecases creating the items.
+func New(p domain.ProjectUsecase, c domain.ColumnUsecase, t domain.TaskUsecase) *Importer {
+	return &Importer{projects: p, columns: c, tasks: t}
+}
+
+// Import creates the project of the plan. The default column of the new project becomes
+// the first planned column. The items created before a failure are left in place.
+func (i *Importer) Import(ctx context.Context, plan *Plan) (domain.Project, error) {
+	project := plan.Project
+	if err := i.projects.Store(ctx, &project); err != nil {
+		return domain.Project{}, fmt.Errorf("store project: %w", err)
+	}
+	columns, err := i.projects.FetchColumns(ctx, project.ID)
+	if err != nil {
+		return project, fmt.Errorf("fetch columns: %w", err)
+	}
+	if len(columns) == 0 {
+		return project, fmt.Errorf("default column: %w", domain.ErrNotFound)
+	}
+	for n, pc := range plan.Columns {
+		column := columns[0]
+		if n == 0 {
+			column.Name, column.Status = pc.Name, pc.Name
+			err = i.columns.Update(ctx, &column)
+		} else {
+			// nolint:exhaustivestruct
+			column = domain.Column{Name: pc.Name, Status: pc.Name, Position: n, ProjectID: project.ID}
+			err = i.projects.StoreColumn(ctx, &column)
+		}
+		if err != nil {
+			return project, fmt.Errorf("store column %q: %w", pc.Name, err)
+		}
+		if err = i.importTasks(ctx, column.ID, pc.Tasks); err != nil {
+			return project, err
+		}
+	}
+
+	return project, nil
+}
+
+func (i *Importer) importTasks(ctx context.Context, columnID uuid.UUID, tasks []PlannedTask) error {
+	for n, pt := range tasks {
+		// nolint:exhaustivestruct
+		tk := domain.Task{Name: pt.Name, Description: pt.Description, ColumnID: columnID, Position: n}
+		if err := i.tasks.Store(ctx, &tk); err != nil {
+			return fmt.Errorf("store task %q: %w", pt.Name, err)
+		}
+		for _, text := range pt.Comments {
+			// nolint:exhaustivestruct
+			if err := i.tasks.StoreComment(ctx, &domain.Comment{TaskID: tk.ID, Text: text}); err != nil {
+				return fmt.Errorf("store comment of task %q: %w", pt.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package trello_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	mocks "github.com/igkostyuk/tasktracker/domain/mock"
+	"github.com/igkostyuk/tasktracker/internal/trello"
+	helper "github.com/matryer/is"
+)
+
+const board = `{
+  "name": "Roadmap",
+  "desc": "Product roadmap",
+  "lists": [
+    {"id": "l2", "name": "Done", "pos": 2},
+    {"id": "l1", "name": "Todo", "pos": 1},
+    {"id": "l3", "name": "Old", "pos": 3, "closed": true},
+    {"id": "l4", "name": "Todo", "pos": 4}
+  ],
+  "cards": [
+    {"id": "c2", "name": "Second", "idList": "l1", "pos": 2},
+    {"id": "c1", "name": "First", "desc": "Details", "idList": "l1", "pos": 1,
+      "labels": [{"name": "bug", "color": "red"}, {"name": "", "color": "green"}]},
+    {"id": "c3", "name": "Archived", "idList": "l1", "pos": 3, "closed": true},
+    {"id": "c4", "name": "Shipped", "idList": "l2", "pos": 1},
+    {"id": "c5", "name": "Forgotten", "idList": "l3", "pos": 1}
+  ],
+  "checklists": [
+    {"idCard": "c1", "name": "Steps", "pos": 1, "checkItems": [
+      {"name": "write", "state": "complete", "pos": 1},
+      {"name": "review", "state": "incomplete", "pos": 2}
+    ]}
+  ],
+  "actions": [
+    {"type": "commentCard", "date": "2020-01-02T10:00:00.000Z",
+      "data": {"text": "later", "card": {"id": "c1"}}, "memberCreator": {"fullName": "Ann"}},
+    {"type": "commentCard", "date": "2020-01-01T10:00:00.000Z",
+      "data": {"text": "first", "card": {"id": "c1"}}, "memberCreator": {"fullName": "Bob"}},
+    {"type": "updateCard", "date": "2020-01-03T10:00:00.000Z", "data": {"card": {"id": "c1"}}}
+  ]
+}`
+
+func TestNewPlan(t *testing.T) {
+	is := helper.New(t)
+
+	b, err := trello.Parse(strings.NewReader(board))
+	is.NoErr(err)
+	plan := trello.NewPlan(&b)
+
+	is.Equal(plan.Project.Name, "Roadmap")
+	is.Equal(plan.Project.Description, "Product roadmap")
+	is.Equal(len(plan.Columns), 3)
+	is.Equal(plan.Columns[0].Name, "Todo")
+	is.Equal(plan.Columns[1].Name, "Done")
+	is.Equal(plan.Columns[2].Name, "Todo (2)")
+	is.Equal(plan.Tasks(), 3)
+	is.Equal(plan.Comments(), 2)
+	is.Equal(plan.Skipped, 3)
+
+	first := plan.Columns[0].Tasks[0]
+	is.Equal(first.Name, "First")
+	is.Equal(first.Description, "Details\n\nLabels: bug, green\n\nSteps:\n- [x] write\n- [ ] review")
+	is.Equal(first.Comments, []string{
+		"Bob on 2020-01-01T10:00:00Z: first",
+		"Ann on 2020-01-02T10:00:00Z: later",
+	})
+	is.Equal(plan.Columns[0].Tasks[1].Name, "Second")
+}
+
+//nolint:exhaustivestruct
+func TestNewPlanLongListNames(t *testing.T) {
+	is := helper.New(t)
+
+	long := strings.Repeat("ö", 300)
+	plan := trello.NewPlan(&trello.Board{Name: "Roadmap", Lists: []trello.List{
+		{ID: "l1", Name: long, Pos: 1},
+		{ID: "l2", Name: long, Pos: 2},
+	}})
+
+	is.Equal(len(plan.Columns), 2)
+	is.Equal(plan.Columns[0].Name, strings.Repeat("ö", 255))
+	is.Equal(plan.Columns[1].Name, strings.Repeat("ö", 251)+" (2)")
+}
+
+//nolint:exhaustivestruct,funlen
+func TestImport(t *testing.T) {
+	is := helper.New(t)
+
+	b, err := trello.Parse(strings.NewReader(board))
+	is.NoErr(err)
+	plan := trello.NewPlan(&b)
+
+	projectID, defaultID := uuid.New(), uuid.New()
+	mp := &mocks.ProjectUsecaseMock{
+		StoreFunc: func(ctx context.Context, pr *domain.Project) error {
+			pr.ID = projectID
+
+			return nil
+		},
+		FetchColumnsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: defaultID, Name: "Default", Status: "Default", ProjectID: id}}, nil
+		},
+		StoreColumnFunc: func(ctx context.Context, c *domain.Column) error {
+			c.ID = uuid.New()
+
+			return nil
+		},
+	}
+	mc := &mocks.ColumnUsecaseMock{
+		UpdateFunc: func(ctx context.Context, cl *domain.Column) error {
+			return nil
+		},
+	}
+	mt := &mocks.TaskUsecaseMock{
+		StoreFunc: func(ctx context.Context, tk *domain.Task) error {
+			tk.ID = uuid.New()
+
+			return nil
+		},
+		StoreCommentFunc: func(ctx context.Context, cm *domain.Comment) error {
+			return nil
+		},
+	}
+	project, err := trello.New(mp, mc, mt).Import(context.TODO(), &plan)
+	is.NoErr(err)
+	is.Equal(project.ID, projectID)
+
+	cu := mc.UpdateCalls()
+	is.Equal(len(cu), 1)
+	is.Equal(cu[0].Cl.ID, defaultID)
+	is.Equal(cu[0].Cl.Name, "Todo")
+	cs := mp.StoreColumnCalls()
+	is.Equal(len(cs), 2)
+	is.Equal(cs[0].In2.Name, "Done")
+	is.Equal(cs[0].In2.Position, 1)
+
+	ts := mt.StoreCalls()
+	is.Equal(len(ts), 3)
+	is.Equal(ts[0].In2.ColumnID, defaultID)
+	is.Equal(ts[1].In2.Position, 1)
+	is.Equal(ts[2].In2.ColumnID, cs[0].In2.ID)
+	is.Equal(len(mt.StoreCommentCalls()), 2)
+	is.Equal(mt.StoreCommentCalls()[0].Cm.TaskID, ts[0].In2.ID)
+}
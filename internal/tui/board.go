@@ -0,0 +1,290 @@
+// Package tui implements the interactive kanban board of a project in the terminal.
+package tui
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/client"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// API is the part of the client used by the board, it is implemented by *client.Client.
+type API interface {
+	ListProjectColumns(ctx context.Context, id uuid.UUID) ([]domain.Column, error)
+	ListColumnTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error)
+	UpdateTask(ctx context.Context, tk domain.Task, opts ...client.TaskOption) (domain.Task, error)
+	ListTaskComments(ctx context.Context, id uuid.UUID) ([]domain.Comment, error)
+	CreateComment(ctx context.Context, cm domain.Comment) (domain.Comment, error)
+}
+
+// The screens of the board.
+type mode int
+
+const (
+	modeBoard mode = iota
+	modeTask
+	modeComment
+)
+
+// column represent a column of the board with its tasks in position order.
+type column struct {
+	domain.Column
+	tasks []domain.Task
+}
+
+// Board is the state of the kanban board, the keys change it and View renders it.
+type Board struct {
+	api     API
+	project domain.Project
+	columns []column
+	// col and row are the cursor, the index of the selected column and of the task in it.
+	col, row int
+	mode     mode
+	task     domain.Task
+	comments []domain.Comment
+	input    []rune
+	status   string
+}
+
+// New will create new a Board of the project, Load fetches its columns.
+func New(api API, project domain.Project) *Board {
+	// nolint:exhaustivestruct
+	return &Board{api: api, project: project}
+}
+
+// Load fetches the columns and the tasks of the project, the cursor stays on the selected task.
+func (b *Board) Load(ctx context.Context) error {
+	selected, hasSelected := b.selected()
+	cls, err := b.api.ListProjectColumns(ctx, b.project.ID)
+	if err != nil {
+		return fmt.Errorf("list columns: %w", err)
+	}
+	columns := make([]column, 0, len(cls))
+	for _, cl := range cls {
+		tasks, err := b.api.ListColumnTasks(ctx, cl.ID)
+		if err != nil {
+			return fmt.Errorf("list tasks of column %q: %w", cl.Name, err)
+		}
+		columns = append(columns, column{Column: cl, tasks: tasks})
+	}
+	b.columns = columns
+	if hasSelected {
+		b.selectTask(selected.ID)
+	}
+	b.clampCursor()
+	if b.mode != modeBoard {
+		for _, c := range b.columns {
+			for _, tk := range c.tasks {
+				if tk.ID == b.task.ID {
+					b.task = tk
+				}
+			}
+		}
+
+		return b.loadComments(ctx)
+	}
+
+	return nil
+}
+
+// Refresh reloads the board and reports the failure in the status line.
+func (b *Board) Refresh(ctx context.Context) {
+	if err := b.Load(ctx); err != nil {
+		b.status = err.Error()
+	}
+}
+
+func (b *Board) loadComments(ctx context.Context) error {
+	comments, err := b.api.ListTaskComments(ctx, b.task.ID)
+	if err != nil {
+		return fmt.Errorf("list comments: %w", err)
+	}
+	b.comments = comments
+
+	return nil
+}
+
+// selected returns the task under the cursor.
+func (b *Board) selected() (domain.Task, bool) {
+	if b.col >= len(b.columns) || b.row >= len(b.columns[b.col].tasks) {
+		return domain.Task{}, false
+	}
+
+	return b.columns[b.col].tasks[b.row], true
+}
+
+// selectTask moves the cursor to the task with the id when it is on the board.
+func (b *Board) selectTask(id uuid.UUID) {
+	for c := range b.columns {
+		for r, tk := range b.columns[c].tasks {
+			if tk.ID == id {
+				b.col, b.row = c, r
+
+				return
+			}
+		}
+	}
+}
+
+func (b *Board) clampCursor() {
+	if b.col >= len(b.columns) {
+		b.col = len(b.columns) - 1
+	}
+	if b.col < 0 {
+		b.col = 0
+	}
+	if b.row < 0 || len(b.columns) == 0 {
+		b.row = 0
+
+		return
+	}
+	if n := len(b.columns[b.col].tasks); b.row >= n {
+		b.row = n - 1
+	}
+	if b.row < 0 {
+		b.row = 0
+	}
+}
+
+// HandleKey applies the key to the board and reports whether the board is closed.
+func (b *Board) HandleKey(ctx context.Context, k Key) bool {
+	if k == KeyInterrupt {
+		return true
+	}
+	switch b.mode {
+	case modeBoard:
+		return b.boardKey(ctx, k)
+	case modeTask:
+		b.taskKey(ctx, k)
+	case modeComment:
+		b.commentKey(ctx, k)
+	}
+
+	return false
+}
+
+func (b *Board) boardKey(ctx context.Context, k Key) bool {
+	b.status = ""
+	switch k {
+	case KeyQuit, KeyEsc:
+		return true
+	case KeyLeft, "h":
+		b.col--
+	case KeyRight, "l":
+		b.col++
+	case KeyUp, "k":
+		b.row--
+	case KeyDown, "j":
+		b.row++
+	case "H":
+		b.moveTask(ctx, -1, 0)
+	case "L":
+		b.moveTask(ctx, 1, 0)
+	case "K":
+		b.moveTask(ctx, 0, -1)
+	case "J":
+		b.moveTask(ctx, 0, 1)
+	case KeyEnter:
+		b.openTask(ctx)
+	case "r":
+		b.Refresh(ctx)
+	}
+	b.clampCursor()
+
+	return false
+}
+
+// moveTask moves the selected task by dc columns, to the end of the column, or by dr positions in its column.
+func (b *Board) moveTask(ctx context.Context, dc, dr int) {
+	tk, ok := b.selected()
+	if !ok {
+		return
+	}
+	col := b.col + dc
+	if col < 0 || col >= len(b.columns) {
+		return
+	}
+	if dc != 0 {
+		tk.ColumnID = b.columns[col].ID
+		// The API places the task after the last one of the column.
+		tk.Position = math.MaxInt32
+	} else {
+		if tk.Position+dr < 0 || tk.Position+dr >= len(b.columns[col].tasks) {
+			return
+		}
+		tk.Position += dr
+	}
+	if _, err := b.api.UpdateTask(ctx, tk); err != nil {
+		b.status = fmt.Sprintf("move %q: %v", tk.Name, err)
+
+		return
+	}
+	b.Refresh(ctx)
+	b.selectTask(tk.ID)
+}
+
+func (b *Board) openTask(ctx context.Context) {
+	tk, ok := b.selected()
+	if !ok {
+		return
+	}
+	b.task = tk
+	b.mode = modeTask
+	b.comments = nil
+	if err := b.loadComments(ctx); err != nil {
+		b.status = err.Error()
+	}
+}
+
+func (b *Board) taskKey(ctx context.Context, k Key) {
+	b.status = ""
+	switch k {
+	case KeyQuit, KeyEsc:
+		b.mode = modeBoard
+	case "c":
+		b.mode = modeComment
+		b.input = b.input[:0]
+	case "r":
+		b.Refresh(ctx)
+	}
+}
+
+func (b *Board) commentKey(ctx context.Context, k Key) {
+	switch k {
+	case KeyEsc:
+		b.mode = modeTask
+	case KeyBackspace:
+		if len(b.input) > 0 {
+			b.input = b.input[:len(b.input)-1]
+		}
+	case KeyEnter:
+		b.addComment(ctx)
+	default:
+		if r := []rune(string(k)); len(r) == 1 {
+			b.input = append(b.input, r[0])
+		}
+	}
+}
+
+func (b *Board) addComment(ctx context.Context) {
+	if len(b.input) == 0 {
+		b.mode = modeTask
+
+		return
+	}
+	// nolint:exhaustivestruct
+	_, err := b.api.CreateComment(ctx, domain.Comment{Text: string(b.input), TaskID: b.task.ID})
+	if err != nil {
+		b.status = fmt.Sprintf("add comment: %v", err)
+
+		return
+	}
+	b.mode = modeTask
+	b.input = b.input[:0]
+	if err = b.loadComments(ctx); err != nil {
+		b.status = err.Error()
+	}
+}
@@ -0,0 +1,165 @@
+package tui_test
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/client"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/tui"
+	helper "github.com/matryer/is"
+)
+
+// fakeAPI keeps a board in memory, the updates move the tasks like the API does.
+type fakeAPI struct {
+	columns  []domain.Column
+	tasks    map[uuid.UUID][]domain.Task
+	updates  []domain.Task
+	comments []domain.Comment
+}
+
+//nolint:exhaustivestruct
+func newFakeAPI() *fakeAPI {
+	todo := domain.Column{ID: uuid.New(), Name: "Todo"}
+	done := domain.Column{ID: uuid.New(), Name: "Done", Position: 1}
+
+	return &fakeAPI{
+		columns: []domain.Column{todo, done},
+		tasks: map[uuid.UUID][]domain.Task{
+			todo.ID: {
+				{ID: uuid.New(), Name: "First", ColumnID: todo.ID},
+				{ID: uuid.New(), Name: "Second", ColumnID: todo.ID, Position: 1},
+			},
+			done.ID: {},
+		},
+	}
+}
+
+func (f *fakeAPI) ListProjectColumns(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+	return f.columns, nil
+}
+
+func (f *fakeAPI) ListColumnTasks(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	return append([]domain.Task(nil), f.tasks[id]...), nil
+}
+
+func (f *fakeAPI) UpdateTask(ctx context.Context, tk domain.Task, opts ...client.TaskOption) (domain.Task, error) {
+	f.updates = append(f.updates, tk)
+	for id, tasks := range f.tasks {
+		for i := range tasks {
+			if tasks[i].ID == tk.ID {
+				f.tasks[id] = append(tasks[:i:i], tasks[i+1:]...)
+			}
+		}
+	}
+	tasks := f.tasks[tk.ColumnID]
+	if tk.Position > len(tasks) {
+		tk.Position = len(tasks)
+	}
+	tasks = append(tasks[:tk.Position:tk.Position], append([]domain.Task{tk}, tasks[tk.Position:]...)...)
+	for i := range tasks {
+		tasks[i].Position = i
+	}
+	f.tasks[tk.ColumnID] = tasks
+
+	return tk, nil
+}
+
+func (f *fakeAPI) ListTaskComments(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+	return f.comments, nil
+}
+
+func (f *fakeAPI) CreateComment(ctx context.Context, cm domain.Comment) (domain.Comment, error) {
+	f.comments = append(f.comments, cm)
+
+	return cm, nil
+}
+
+//nolint:exhaustivestruct
+func newBoard(t *testing.T, api *fakeAPI) *tui.Board {
+	t.Helper()
+	b := tui.New(api, domain.Project{ID: uuid.New(), Name: "Roadmap"})
+	if err := b.Load(context.Background()); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	return b
+}
+
+func press(b *tui.Board, keys ...tui.Key) bool {
+	for _, k := range keys {
+		if b.HandleKey(context.Background(), k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestView(t *testing.T) {
+	is := helper.New(t)
+	b := newBoard(t, newFakeAPI())
+
+	lines := strings.Split(b.View(40, 20), "\r\n")
+	is.True(strings.Contains(lines[0], "Roadmap"))
+	is.True(strings.Contains(lines[2], "Todo (2)"))
+	is.True(strings.Contains(lines[2], "Done (0)"))
+	is.True(strings.HasPrefix(lines[3], "\x1b[7mFirst"))
+	is.True(strings.HasPrefix(lines[4], "Second"))
+
+	press(b, tui.KeyDown)
+	lines = strings.Split(b.View(40, 20), "\r\n")
+	is.True(strings.HasPrefix(lines[4], "\x1b[7mSecond"))
+}
+
+func TestMoveTask(t *testing.T) {
+	is := helper.New(t)
+	api := newFakeAPI()
+	b := newBoard(t, api)
+	first := api.tasks[api.columns[0].ID][0]
+
+	is.True(!press(b, "J"))
+	is.Equal(api.updates[0].ID, first.ID)
+	is.Equal(api.updates[0].Position, 1)
+	is.Equal(api.tasks[api.columns[0].ID][1].ID, first.ID)
+
+	press(b, "L")
+	is.Equal(api.updates[1].ColumnID, api.columns[1].ID)
+	is.Equal(api.updates[1].Position, math.MaxInt32)
+	is.Equal(api.tasks[api.columns[1].ID][0].ID, first.ID)
+
+	// The cursor follows the moved task, so moving right again is a no-op at the last column.
+	press(b, "L")
+	is.Equal(len(api.updates), 2)
+	press(b, "H")
+	is.Equal(api.updates[2].ColumnID, api.columns[0].ID)
+}
+
+func TestComments(t *testing.T) {
+	is := helper.New(t)
+	api := newFakeAPI()
+	b := newBoard(t, api)
+
+	press(b, tui.KeyEnter)
+	is.True(strings.Contains(b.View(40, 20), "Comments (0)"))
+	press(b, "c", "o", "k", "x", tui.KeyBackspace, "q", tui.KeyEnter)
+	is.Equal(len(api.comments), 1)
+	is.Equal(api.comments[0].Text, "okq")
+	is.Equal(api.comments[0].TaskID, api.tasks[api.columns[0].ID][0].ID)
+	is.True(strings.Contains(b.View(40, 20), "Comments (1)"))
+
+	is.True(!press(b, tui.KeyEsc))
+	is.True(strings.Contains(b.View(40, 20), "Todo (2)"))
+	is.True(press(b, tui.KeyQuit))
+}
+
+func TestParseKeys(t *testing.T) {
+	is := helper.New(t)
+
+	is.Equal(tui.ParseKeys([]byte("\x1b[Aj\ré\x7f\x1b\x03")), []tui.Key{
+		tui.KeyUp, "j", tui.KeyEnter, "é", tui.KeyBackspace, tui.KeyEsc, tui.KeyInterrupt,
+	})
+}
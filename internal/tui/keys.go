@@ -0,0 +1,81 @@
+package tui
+
+import "unicode/utf8"
+
+// Key is a key read from the terminal, a printable key is its character.
+type Key string
+
+// The named keys.
+const (
+	KeyUp        Key = "up"
+	KeyDown      Key = "down"
+	KeyLeft      Key = "left"
+	KeyRight     Key = "right"
+	KeyEnter     Key = "enter"
+	KeyEsc       Key = "esc"
+	KeyBackspace Key = "backspace"
+	KeyInterrupt Key = "ctrl+c"
+	KeyQuit      Key = "q"
+)
+
+// The escape sequences of the arrow keys.
+var arrows = map[string]Key{
+	"\x1b[A": KeyUp,
+	"\x1b[B": KeyDown,
+	"\x1b[C": KeyRight,
+	"\x1b[D": KeyLeft,
+	"\x1bOA": KeyUp,
+	"\x1bOB": KeyDown,
+	"\x1bOC": KeyRight,
+	"\x1bOD": KeyLeft,
+}
+
+// arrowLength is the length of the escape sequences of the arrow keys.
+const arrowLength = 3
+
+// ParseKeys splits the input read from a terminal in raw mode into keys,
+// the unknown escape sequences and control characters are dropped.
+func ParseKeys(b []byte) []Key {
+	var keys []Key
+	for len(b) > 0 {
+		if b[0] == 0x1b {
+			if len(b) >= arrowLength {
+				if k, ok := arrows[string(b[:arrowLength])]; ok {
+					keys = append(keys, k)
+					b = b[arrowLength:]
+
+					continue
+				}
+			}
+			if len(b) == 1 || (b[1] != '[' && b[1] != 'O') {
+				keys = append(keys, KeyEsc)
+				b = b[1:]
+
+				continue
+			}
+			b = b[len(b):]
+
+			continue
+		}
+		switch b[0] {
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+		case 0x7f, 0x08:
+			keys = append(keys, KeyBackspace)
+		case 0x03:
+			keys = append(keys, KeyInterrupt)
+		}
+		if b[0] < 0x20 || b[0] == 0x7f {
+			b = b[1:]
+
+			continue
+		}
+		r, size := utf8.DecodeRune(b)
+		if r != utf8.RuneError {
+			keys = append(keys, Key(string(r)))
+		}
+		b = b[size:]
+	}
+
+	return keys
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// The escape sequences switching the terminal to the alternate screen and back.
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l"
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+// ErrNotTerminal is returned when the input of the board is not a terminal.
+var ErrNotTerminal = errors.New("the board needs a terminal")
+
+// The size of a terminal when it cannot be read.
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// Run shows the board in the terminal until it is closed. The API has no event stream,
+// so the board is reloaded every refresh interval to show the changes made by others.
+func Run(ctx context.Context, b *Board, in *os.File, out io.Writer, refresh time.Duration) error {
+	fd := int(in.Fd())
+	if !term.IsTerminal(fd) {
+		return ErrNotTerminal
+	}
+	if err := b.Load(ctx); err != nil {
+		return err
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("raw terminal: %w", err)
+	}
+	defer func() {
+		_ = term.Restore(fd, state)
+	}()
+	fmt.Fprint(out, enterScreen)
+	defer fmt.Fprint(out, leaveScreen)
+
+	keys := make(chan []Key)
+	done := make(chan struct{})
+	defer close(done)
+	go readKeys(in, keys, done)
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = defaultWidth, defaultHeight
+		}
+		fmt.Fprint(out, clearScreen+b.View(width, height))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			b.Refresh(ctx)
+		case ks, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, k := range ks {
+				if b.HandleKey(ctx, k) {
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// readKeys sends the keys read from the terminal until it is closed or the board is done.
+func readKeys(in io.Reader, keys chan<- []Key, done <-chan struct{}) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			select {
+			case keys <- ParseKeys(buf[:n]):
+			case <-done:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
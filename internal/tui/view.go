@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The layout of the board.
+const (
+	minColumnWidth = 12
+	columnGap      = 1
+	// chromeLines are the lines of the screen taken by the title, the column headers and the help.
+	chromeLines = 5
+	timeLayout  = "2006-01-02 15:04"
+)
+
+// The escape sequences styling the selected task and the column headers.
+const (
+	reverse = "\x1b[7m"
+	bold    = "\x1b[1m"
+	reset   = "\x1b[0m"
+)
+
+const (
+	boardHelp   = "←↓↑→/hjkl select  H/L move to column  J/K reorder  enter open  r refresh  q quit"
+	taskHelp    = "c comment  r refresh  esc back"
+	commentHelp = "enter save  esc cancel"
+)
+
+// View renders the board to fit the width and height of the terminal, the lines end with \r\n
+// as the terminal is in raw mode.
+func (b *Board) View(width, height int) string {
+	var lines []string
+	switch b.mode {
+	case modeBoard:
+		lines = b.boardView(width, height)
+	case modeTask, modeComment:
+		lines = b.taskView(width, height)
+	}
+
+	return strings.Join(lines, "\r\n")
+}
+
+func (b *Board) boardView(width, height int) []string {
+	lines := []string{bold + fit(b.project.Name, width) + reset, ""}
+	if len(b.columns) == 0 {
+		return append(lines, "no columns", "", b.footer(boardHelp, width))
+	}
+	colWidth := (width - columnGap*(len(b.columns)-1)) / len(b.columns)
+	if colWidth < minColumnWidth {
+		colWidth = minColumnWidth
+	}
+	// The columns that do not fit are scrolled to keep the selected one visible.
+	visible := (width + columnGap) / (colWidth + columnGap)
+	if visible < 1 {
+		visible = 1
+	}
+	first := 0
+	if b.col >= visible {
+		first = b.col - visible + 1
+	}
+	last := first + visible
+	if last > len(b.columns) {
+		last = len(b.columns)
+	}
+	gap := strings.Repeat(" ", columnGap)
+	header := make([]string, 0, last-first)
+	rows := 0
+	for _, c := range b.columns[first:last] {
+		title := fmt.Sprintf("%s (%d)", c.Name, len(c.tasks))
+		if c.WIPLimit != nil {
+			title = fmt.Sprintf("%s (%d/%d)", c.Name, len(c.tasks), *c.WIPLimit)
+		}
+		header = append(header, bold+pad(title, colWidth)+reset)
+		if len(c.tasks) > rows {
+			rows = len(c.tasks)
+		}
+	}
+	lines = append(lines, strings.Join(header, gap))
+	maxRows := height - chromeLines
+	if maxRows < 1 {
+		maxRows = 1
+	}
+	if rows > maxRows {
+		rows = maxRows
+	}
+	// The rows are scrolled to keep the selected task visible.
+	top := 0
+	if b.row >= rows {
+		top = b.row - rows + 1
+	}
+	for r := top; r < top+rows; r++ {
+		cells := make([]string, 0, last-first)
+		for i, c := range b.columns[first:last] {
+			if r >= len(c.tasks) {
+				cells = append(cells, pad("", colWidth))
+
+				continue
+			}
+			cell := pad(c.tasks[r].Name, colWidth)
+			if first+i == b.col && r == b.row {
+				cell = reverse + cell + reset
+			}
+			cells = append(cells, cell)
+		}
+		lines = append(lines, strings.Join(cells, gap))
+	}
+
+	return append(lines, "", b.footer(boardHelp, width))
+}
+
+func (b *Board) taskView(width, height int) []string {
+	lines := []string{bold + fit(b.task.Name, width) + reset, ""}
+	for _, l := range strings.Split(b.task.Description, "\n") {
+		lines = append(lines, wrap(l, width)...)
+	}
+	lines = append(lines, "", bold+fmt.Sprintf("Comments (%d)", len(b.comments))+reset)
+	for _, cm := range b.comments {
+		lines = append(lines, wrap(cm.CreatedAt.Local().Format(timeLayout)+"  "+cm.Text, width)...)
+	}
+	help := taskHelp
+	if b.mode == modeComment {
+		help = commentHelp
+		lines = append(lines, "", fit("comment: "+string(b.input), width-1)+"_")
+	}
+	// The first lines are dropped to keep the newest comments and the input on the screen.
+	if maxLines := height - 2; maxLines > 0 && len(lines) > maxLines {
+		lines = append(lines[:1], lines[len(lines)-maxLines+1:]...)
+	}
+
+	return append(lines, "", b.footer(help, width))
+}
+
+// footer returns the status line when there is a status and the help otherwise.
+func (b *Board) footer(help string, width int) string {
+	if b.status != "" {
+		return reverse + fit(b.status, width) + reset
+	}
+
+	return fit(help, width)
+}
+
+// fit cuts the first line of s to n runes.
+func fit(s string, n int) string {
+	if i := strings.IndexAny(s, "\r\n"); i >= 0 {
+		s = s[:i]
+	}
+	r := []rune(s)
+	if n <= 0 {
+		return ""
+	}
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n-1]) + "…"
+}
+
+// pad fits s into exactly n runes.
+func pad(s string, n int) string {
+	s = fit(s, n)
+
+	return s + strings.Repeat(" ", n-len([]rune(s)))
+}
+
+// wrap splits s into lines of at most n runes.
+func wrap(s string, n int) []string {
+	r := []rune(s)
+	if n <= 0 || len(r) <= n {
+		return []string{s}
+	}
+	var lines []string
+	for len(r) > n {
+		lines = append(lines, string(r[:n]))
+		r = r[n:]
+	}
+
+	return append(lines, string(r))
+}
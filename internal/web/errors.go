@@ -0,0 +1,101 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ProblemTypeInternal is the problem type of the server errors, their details are hidden from the clients.
+const ProblemTypeInternal = "urn:tasktracker:problem:internal"
+
+// InternalMessage is sent to the clients instead of the text of the server errors.
+const InternalMessage = "the request could not be completed, report the request ID to the administrator"
+
+// ErrorSpec declares how a domain error is shown to the clients.
+type ErrorSpec struct {
+	// Status is the HTTP status code of the responses with the error.
+	Status int
+	// Type is the problem type URI of the error.
+	Type string
+	// Message is the public message of the error, sent as the problem title.
+	Message string
+}
+
+type registeredError struct {
+	err  error
+	spec ErrorSpec
+}
+
+// errorRegistry is the list of the known errors, the first one matching an error with errors.Is wins.
+var errorRegistry = []registeredError{
+	{domain.ErrNotFound, ErrorSpec{http.StatusNotFound, "urn:tasktracker:problem:not-found", "Item not found"}},
+	{domain.ErrConflict, ErrorSpec{http.StatusConflict, "urn:tasktracker:problem:conflict", "Item already exists"}},
+	{domain.ErrUnique, ErrorSpec{http.StatusConflict, "urn:tasktracker:problem:unique", "Value must be unique"}},
+	{domain.ErrBadParamInput, ErrorSpec{
+		http.StatusBadRequest, "urn:tasktracker:problem:bad-param", "Request parameter is not valid",
+	}},
+	{domain.ErrLastColumn, ErrorSpec{
+		http.StatusConflict, "urn:tasktracker:problem:last-column", "Last column cannot be deleted",
+	}},
+	{domain.ErrParentCycle, ErrorSpec{
+		http.StatusConflict, "urn:tasktracker:problem:parent-cycle", "Task cannot be its own ancestor",
+	}},
+	{domain.ErrParentProject, ErrorSpec{
+		http.StatusBadRequest, "urn:tasktracker:problem:parent-project", "Parent task is in another project",
+	}},
+	{domain.ErrLinkCycle, ErrorSpec{
+		http.StatusConflict, "urn:tasktracker:problem:link-cycle", "Blocking links cannot form a cycle",
+	}},
+	{domain.ErrBlocked, ErrorSpec{http.StatusConflict, "urn:tasktracker:problem:blocked", "Task is blocked"}},
+	{domain.ErrWIPLimit, ErrorSpec{
+		http.StatusConflict, "urn:tasktracker:problem:wip-limit", "Work in progress limit reached",
+	}},
+	{domain.ErrArchived, ErrorSpec{http.StatusConflict, "urn:tasktracker:problem:archived", "Project is archived"}},
+	{domain.ErrForbidden, ErrorSpec{http.StatusForbidden, "urn:tasktracker:problem:forbidden", "Action not allowed"}},
+	{domain.ErrInternalServerError, ErrorSpec{http.StatusInternalServerError, ProblemTypeInternal, "Internal error"}},
+}
+
+// RegisterError declares the status, problem type and public message of the error,
+// it must be called before the server starts.
+func RegisterError(err error, spec ErrorSpec) {
+	errorRegistry = append(errorRegistry, registeredError{err: err, spec: spec})
+}
+
+// LookupError returns the spec of the registered error matching err.
+func LookupError(err error) (ErrorSpec, bool) {
+	for _, re := range errorRegistry {
+		if errors.Is(err, re.err) {
+			return re.spec, true
+		}
+	}
+
+	return ErrorSpec{}, false
+}
+
+// ErrorOfType returns the registered error with the problem type, nil when there is none.
+func ErrorOfType(problemType string) error {
+	for _, re := range errorRegistry {
+		if re.spec.Type == problemType {
+			return re.err
+		}
+	}
+
+	return nil
+}
+
+// StatusCode returns the HTTP status code of the error, the validation errors are bad requests
+// and the errors that are not registered are internal server errors.
+func StatusCode(err error) int {
+	var verr validator.ValidationErrors
+	if errors.As(err, &verr) {
+		return http.StatusBadRequest
+	}
+	if spec, ok := LookupError(err); ok {
+		return spec.Status
+	}
+
+	return http.StatusInternalServerError
+}
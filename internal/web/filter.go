@@ -0,0 +1,74 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// ParseFilter reads the sort and modified_since query params of a listing request.
+// The sort param is a sort key optionally prefixed with "-" for the descending order.
+func ParseFilter(r *http.Request) (domain.Filter, error) {
+	var f domain.Filter
+	q := r.URL.Query()
+	if sort := q.Get("sort"); sort != "" {
+		f.Desc = strings.HasPrefix(sort, "-")
+		f.SortBy = strings.TrimPrefix(sort, "-")
+		if f.SortBy != domain.SortCreatedAt && f.SortBy != domain.SortUpdatedAt {
+			return domain.Filter{}, fmt.Errorf("sort %q: %w", sort, domain.ErrBadParamInput)
+		}
+	}
+	if since := q.Get("modified_since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return domain.Filter{}, fmt.Errorf("modified_since %q: %w", since, domain.ErrBadParamInput)
+		}
+		f.ModifiedSince = t.UTC()
+	}
+
+	return f, nil
+}
+
+// flowRangeDays is the length of the default date range of the reports.
+const flowRangeDays = 30
+
+// ParseRange reads the from and to query params of a report request as RFC 3339 times or dates.
+// A to date includes the whole day, the range defaults to the last 30 days.
+func ParseRange(r *http.Request) (from, to time.Time, err error) {
+	q := r.URL.Query()
+	to = time.Now().UTC()
+	if v := q.Get("to"); v != "" {
+		if to, err = parseTime(v, true); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to %q: %w", v, domain.ErrBadParamInput)
+		}
+	}
+	from = to.AddDate(0, 0, -flowRangeDays)
+	if v := q.Get("from"); v != "" {
+		if from, err = parseTime(v, false); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from %q: %w", v, domain.ErrBadParamInput)
+		}
+	}
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be before to: %w", domain.ErrBadParamInput)
+	}
+
+	return from, to, nil
+}
+
+func parseTime(v string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
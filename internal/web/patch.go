@@ -0,0 +1,83 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+)
+
+// MergePatchType is the media type of the RFC 7396 JSON merge patches.
+const MergePatchType = "application/merge-patch+json"
+
+// ErrMergePatchType will throw if the body of a PATCH request is not a JSON merge patch.
+var ErrMergePatchType = errors.New("content type must be " + MergePatchType)
+
+// IsMergePatch reports whether the request body is a JSON merge patch,
+// application/json is accepted for the clients that cannot set the media type.
+func IsMergePatch(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return mediaType == MergePatchType || mediaType == "application/json"
+}
+
+// MergePatch applies the RFC 7396 merge patch read from patch to v, a pointer to a struct,
+// through the JSON encoding of v. The members removed by the patch are left zero.
+func MergePatch(v interface{}, patch io.Reader) error {
+	var p interface{}
+	dec := json.NewDecoder(patch)
+	dec.UseNumber()
+	if err := dec.Decode(&p); err != nil {
+		return fmt.Errorf("decode merge patch: %w", err)
+	}
+	doc, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode patched document: %w", err)
+	}
+	var target interface{}
+	dec = json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	if err = dec.Decode(&target); err != nil {
+		return fmt.Errorf("decode patched document: %w", err)
+	}
+	if doc, err = json.Marshal(mergePatch(target, p)); err != nil {
+		return fmt.Errorf("encode merged document: %w", err)
+	}
+	// Decode into the zero value, so the removed members do not keep their old values.
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+	if err = json.Unmarshal(doc, v); err != nil {
+		return fmt.Errorf("apply merge patch: %w", err)
+	}
+
+	return nil
+}
+
+// mergePatch implements the MergePatch function of RFC 7396.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{}, len(p))
+	}
+	for name, value := range p {
+		if value == nil {
+			delete(t, name)
+
+			continue
+		}
+		t[name] = mergePatch(t[name], value)
+	}
+
+	return t
+}
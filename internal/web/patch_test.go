@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/tasktracker/internal/web"
+	helper "github.com/matryer/is"
+)
+
+type entity struct {
+	Name     string            `json:"name"`
+	Limit    *int              `json:"limit,omitempty"`
+	Position int               `json:"position"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Tags     []string          `json:"tags"`
+	At       time.Time         `json:"at"`
+}
+
+//nolint:funlen
+func TestMergePatch(t *testing.T) {
+	limit := 3
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	stored := entity{
+		Name:     "stored",
+		Limit:    &limit,
+		Position: 2,
+		Labels:   map[string]string{"a": "1", "b": "2"},
+		Tags:     []string{"x", "y"},
+		At:       at,
+	}
+	tt := []struct {
+		name  string
+		patch string
+		want  entity
+	}{
+		{
+			name:  "replaces a member",
+			patch: `{"name":"patched"}`,
+			want:  entity{Name: "patched", Limit: &limit, Position: 2, Labels: stored.Labels, Tags: stored.Tags, At: at},
+		},
+		{
+			name:  "removes the null members",
+			patch: `{"limit":null,"position":null}`,
+			want:  entity{Name: "stored", Limit: nil, Position: 0, Labels: stored.Labels, Tags: stored.Tags, At: at},
+		},
+		{
+			name:  "merges the objects",
+			patch: `{"labels":{"a":null,"c":"3"}}`,
+			want: entity{
+				Name: "stored", Limit: &limit, Position: 2, Labels: map[string]string{"b": "2", "c": "3"},
+				Tags: stored.Tags, At: at,
+			},
+		},
+		{
+			name:  "replaces the arrays",
+			patch: `{"tags":["z"]}`,
+			want:  entity{Name: "stored", Limit: &limit, Position: 2, Labels: stored.Labels, Tags: []string{"z"}, At: at},
+		},
+		{
+			name:  "keeps the entity for an empty patch",
+			patch: `{}`,
+			want:  stored,
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			e := stored
+			e.Labels = map[string]string{"a": "1", "b": "2"}
+
+			is.NoErr(web.MergePatch(&e, strings.NewReader(tc.patch)))
+			is.Equal(e, tc.want)
+		})
+	}
+	t.Run("rejects an invalid patch", func(t *testing.T) {
+		is := helper.New(t)
+		e := stored
+
+		is.True(web.MergePatch(&e, strings.NewReader(`{"name":`)) != nil)
+		is.True(web.MergePatch(&e, strings.NewReader(`{"position":"first"}`)) != nil)
+	})
+}
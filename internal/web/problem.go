@@ -0,0 +1,158 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/middleware"
+)
+
+// ProblemType is the media type of the RFC 7807 problem details of the error responses.
+const ProblemType = "application/problem+json"
+
+// The problem types of the error responses. The problems without a more specific type
+// are about:blank, their title is the status text. The types of the domain errors are registered
+// with RegisterError.
+const (
+	ProblemTypeBlank      = "about:blank"
+	ProblemTypeValidation = "urn:tasktracker:problem:validation"
+)
+
+// Problem represent the RFC 7807 problem details of an error response.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError represent a field of the request body that failed validation.
+type FieldError = domain.FieldError
+
+// NewProblem returns the problem details of the error with the status for the request.
+// The registered errors with the status get their problem type and public message, the details
+// of the server errors are hidden.
+func NewProblem(r *http.Request, err error, status int) Problem {
+	// nolint:exhaustivestruct
+	p := Problem{
+		Type:      ProblemTypeBlank,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    err.Error(),
+		Instance:  r.URL.Path,
+		RequestID: middleware.GetRequestID(r.Context()),
+	}
+	if status >= http.StatusInternalServerError {
+		p.Type = ProblemTypeInternal
+		p.Detail = InternalMessage
+
+		return p
+	}
+	if fes := FieldErrors(err); fes != nil {
+		p.Type = ProblemTypeValidation
+		p.Title = "Request validation failed"
+		p.Errors = fes
+		p.Detail = ValidationDetail(fes)
+
+		return p
+	}
+	if spec, ok := LookupError(err); ok && spec.Status == status {
+		p.Type = spec.Type
+		p.Title = spec.Message
+	}
+
+	return p
+}
+
+// validate checks the request bodies, the failed fields are named by their JSON names.
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+
+		return name
+	})
+
+	return v
+}
+
+// Validate validates the struct pointed by v with the validate tags of its fields,
+// the error is validator.ValidationErrors when a field fails validation.
+func Validate(v interface{}) error {
+	return validate.Struct(v)
+}
+
+// FieldErrors returns the fields that failed validation in err, nil when err is not a validation error.
+func FieldErrors(err error) []FieldError {
+	var verr validator.ValidationErrors
+	if !errors.As(err, &verr) {
+		return nil
+	}
+	fes := make([]FieldError, 0, len(verr))
+	for _, fe := range verr {
+		field := fe.Namespace()
+		// The namespace starts with the name of the validated struct.
+		if i := strings.IndexByte(field, '.'); i >= 0 {
+			field = field[i+1:]
+		}
+		fes = append(fes, FieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: fieldMessage(fe),
+		})
+	}
+
+	return fes
+}
+
+// ValidationDetail describes the failed fields to the client, e.g. "validation: name is required".
+func ValidationDetail(fes []FieldError) string {
+	messages := make([]string, 0, len(fes))
+	for _, fe := range fes {
+		messages = append(messages, fe.Field+" "+fe.Message)
+	}
+
+	return "validation: " + strings.Join(messages, ", ")
+}
+
+// fieldMessage describes the failed rule of the field to the client.
+func fieldMessage(fe validator.FieldError) string {
+	unit := ""
+	switch fe.Kind() {
+	case reflect.String:
+		unit = " characters long"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		unit = " items long"
+	}
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s%s", fe.Param(), unit)
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s%s", fe.Param(), unit)
+	case "len":
+		return fmt.Sprintf("must be exactly %s%s", fe.Param(), unit)
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fe.Param()), ", ")
+	default:
+		return fmt.Sprintf("failed the %s rule", fe.Tag())
+	}
+}
@@ -0,0 +1,140 @@
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/middleware"
+	"github.com/igkostyuk/tasktracker/internal/web"
+	helper "github.com/matryer/is"
+)
+
+type board struct {
+	Name    string `json:"name" validate:"required,max=5"`
+	Columns []lane `json:"columns" validate:"required,min=1,dive"`
+}
+
+type lane struct {
+	Status string `json:"status" validate:"oneof=todo done"`
+}
+
+// respond records the response written by send with a request for /v1/projects.
+func respond(
+	t *testing.T, send func(w http.ResponseWriter, r *http.Request),
+) (*httptest.ResponseRecorder, web.Problem) {
+	t.Helper()
+	is := helper.New(t)
+	r, rerr := http.NewRequestWithContext(
+		middleware.WithRequestID(context.Background(), "req-1"), http.MethodPost, "/v1/projects", nil,
+	)
+	is.NoErr(rerr)
+	w := httptest.NewRecorder()
+
+	send(w, r)
+
+	var p web.Problem
+	is.NoErr(json.NewDecoder(w.Body).Decode(&p))
+
+	return w, p
+}
+
+//nolint:exhaustivestruct
+func TestRespondValidationError(t *testing.T) {
+	is := helper.New(t)
+	is.NoErr(web.Validate(&board{Name: "a", Columns: []lane{{Status: "done"}}}))
+	err := web.Validate(&board{Name: "roadmap", Columns: []lane{{Status: "todo"}, {Status: "doing"}}})
+	is.True(err != nil)
+
+	w, p := respond(t, func(w http.ResponseWriter, r *http.Request) {
+		web.RespondError(w, r, fmt.Errorf("validation: %w", err))
+	})
+
+	is.Equal(w.Code, http.StatusBadRequest)
+	is.Equal(w.Header().Get("Content-Type"), web.ProblemType)
+	is.Equal(p.Type, web.ProblemTypeValidation)
+	is.Equal(p.Status, http.StatusBadRequest)
+	is.Equal(p.Instance, "/v1/projects")
+	is.Equal(p.RequestID, "req-1")
+	is.Equal(p.Detail, "validation: name must be at most 5 characters long, columns[1].status must be one of todo, done")
+	is.Equal(p.Errors, []web.FieldError{
+		{Field: "name", Rule: "max", Param: "5", Message: "must be at most 5 characters long"},
+		{Field: "columns[1].status", Rule: "oneof", Param: "todo done", Message: "must be one of todo, done"},
+	})
+}
+
+func TestRespondError(t *testing.T) {
+	t.Run("registered error", func(t *testing.T) {
+		is := helper.New(t)
+
+		w, p := respond(t, func(w http.ResponseWriter, r *http.Request) {
+			web.RespondError(w, r, fmt.Errorf("fetch: %w", domain.ErrNotFound))
+		})
+
+		is.Equal(w.Code, http.StatusNotFound)
+		is.Equal(p.Type, "urn:tasktracker:problem:not-found")
+		is.Equal(p.Title, "Item not found")
+		is.Equal(p.Detail, "fetch: "+domain.ErrNotFound.Error())
+		is.Equal(p.RequestID, "req-1")
+		is.Equal(len(p.Errors), 0)
+	})
+	t.Run("status of a registered error", func(t *testing.T) {
+		is := helper.New(t)
+
+		w, p := respond(t, func(w http.ResponseWriter, r *http.Request) {
+			web.RespondError(w, r, fmt.Errorf("store: %w", domain.ErrWIPLimit))
+		})
+
+		is.Equal(w.Code, http.StatusConflict)
+		is.Equal(p.Status, http.StatusConflict)
+		is.Equal(p.Type, "urn:tasktracker:problem:wip-limit")
+	})
+	t.Run("status of a request error", func(t *testing.T) {
+		is := helper.New(t)
+
+		w, p := respond(t, func(w http.ResponseWriter, r *http.Request) {
+			web.RespondRequestError(w, r, domain.ErrBadParamInput, http.StatusMethodNotAllowed)
+		})
+
+		is.Equal(w.Code, http.StatusMethodNotAllowed)
+		is.Equal(p.Type, web.ProblemTypeBlank)
+		is.Equal(p.Title, "Method Not Allowed")
+		is.Equal(p.Detail, domain.ErrBadParamInput.Error())
+	})
+	t.Run("internal error is hidden", func(t *testing.T) {
+		is := helper.New(t)
+
+		w, p := respond(t, func(w http.ResponseWriter, r *http.Request) {
+			web.RespondError(w, r, errors.New("sql: connection refused"))
+		})
+
+		is.Equal(w.Code, http.StatusInternalServerError)
+		is.Equal(p.Type, web.ProblemTypeInternal)
+		is.Equal(p.Title, "Internal Server Error")
+		is.Equal(p.Detail, web.InternalMessage)
+		is.Equal(p.RequestID, "req-1")
+	})
+}
+
+func TestStatusCode(t *testing.T) {
+	is := helper.New(t)
+	errCustom := errors.New("custom")
+	web.RegisterError(errCustom, web.ErrorSpec{
+		Status: http.StatusTeapot, Type: "urn:tasktracker:problem:custom", Message: "Custom",
+	})
+
+	is.Equal(web.StatusCode(fmt.Errorf("delete: %w", domain.ErrLastColumn)), http.StatusConflict)
+	is.Equal(web.StatusCode(domain.ErrUnique), http.StatusConflict)
+	is.Equal(web.StatusCode(domain.ErrBadParamInput), http.StatusBadRequest)
+	is.Equal(web.StatusCode(web.Validate(&board{})), http.StatusBadRequest)
+	is.Equal(web.StatusCode(errors.New("sql: connection refused")), http.StatusInternalServerError)
+	is.Equal(web.StatusCode(errCustom), http.StatusTeapot)
+	is.Equal(web.ErrorOfType("urn:tasktracker:problem:custom"), errCustom)
+	is.Equal(web.ErrorOfType("urn:tasktracker:problem:wip-limit"), domain.ErrWIPLimit)
+	is.Equal(web.ErrorOfType(web.ProblemTypeBlank), nil)
+}
@@ -1,7 +1,9 @@
 package web
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -13,7 +15,7 @@ func Respond(w http.ResponseWriter, r *http.Request, data interface{}, statusCod
 	// Convert the response value to JSON.
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		RespondError(w, r, err, http.StatusInternalServerError)
+		RespondError(w, r, err)
 
 		return
 	}
@@ -23,29 +25,54 @@ func Respond(w http.ResponseWriter, r *http.Request, data interface{}, statusCod
 	w.WriteHeader(statusCode)
 	// Send the result back to the client.
 	if _, err = w.Write(jsonData); err != nil {
-		logError(r, err)
+		LogError(r, err)
 	}
 }
 
-// RespondError sent error JSON message to the client.
-func RespondError(w http.ResponseWriter, r *http.Request, err error, status int) {
-	er := HTTPError{
-		Code:    status,
-		Message: err.Error(),
+// RespondCSV sends the records to the client as a CSV attachment with the filename.
+func RespondCSV(w http.ResponseWriter, r *http.Request, records [][]string, filename string, statusCode int) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(statusCode)
+	if err := csv.NewWriter(w).WriteAll(records); err != nil {
+		LogError(r, err)
 	}
-	if status == http.StatusInternalServerError {
-		logError(r, err)
+}
+
+// RespondRaw sends the already encoded data to the client with the content type.
+func RespondRaw(w http.ResponseWriter, r *http.Request, data []byte, contentType string, statusCode int) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		LogError(r, err)
 	}
-	Respond(w, r, er, status)
 }
 
-// HTTPError represent error respons message.
-type HTTPError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+// RespondError sends the problem details of the error to the client with the status of the error
+// given by StatusCode, the server errors are logged with the request ID.
+func RespondError(w http.ResponseWriter, r *http.Request, err error) {
+	RespondRequestError(w, r, err, StatusCode(err))
+}
+
+// RespondRequestError sends the problem details of the error with the status to the client.
+// It is meant for the errors of the HTTP request itself, e.g. an unsupported media type
+// or a body that cannot be decoded, the other errors are sent with RespondError.
+func RespondRequestError(w http.ResponseWriter, r *http.Request, err error, status int) {
+	if status >= http.StatusInternalServerError {
+		LogError(r, err)
+	}
+	data, merr := json.Marshal(NewProblem(r, err, status))
+	if merr != nil {
+		LogError(r, merr)
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+	RespondRaw(w, r, data, ProblemType, status)
 }
 
-func logError(r *http.Request, err error) {
+// LogError logs the error of the request with its request ID.
+func LogError(r *http.Request, err error) {
 	logEntry := middleware.GetLogEntry(r)
 	if logEntry != nil {
 		logEntry.WriteError(err)
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+type linkRepository struct {
+	db *sql.DB
+}
+
+// New will create new a LinkRepository object representation of domain.LinkRepository interface.
+func New(db *sql.DB) domain.LinkRepository {
+	return &linkRepository{db: db}
+}
+
+// FetchByTaskID returns links in both directions as seen from the side of the task.
+func (l *linkRepository) FetchByTaskID(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+	query := `SELECT id, type, task_id, linked_task_id FROM task_links WHERE (task_id = $1 OR linked_task_id = $1)
+	AND NOT EXISTS (SELECT 1 FROM tasks WHERE id IN (task_id, linked_task_id) AND deleted_at IS NOT NULL)`
+	rows, err := l.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+	result := make([]domain.Link, 0)
+	for rows.Next() {
+		t := domain.Link{}
+		err = rows.Scan(
+			&t.ID,
+			&t.Type,
+			&t.TaskID,
+			&t.LinkedTaskID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows scan error: %w", err)
+		}
+		if t.LinkedTaskID == id {
+			t = t.Inverse()
+		}
+		result = append(result, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("encountered during iteration %w", err)
+	}
+
+	return result, nil
+}
+
+func (l *linkRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Link, error) {
+	query := `SELECT id, type, task_id, linked_task_id FROM task_links WHERE id = $1`
+	row := l.db.QueryRowContext(ctx, query, id)
+	res := domain.Link{}
+	err := row.Scan(
+		&res.ID,
+		&res.Type,
+		&res.TaskID,
+		&res.LinkedTaskID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Link{}, fmt.Errorf("link: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return domain.Link{}, fmt.Errorf("getOne error: %w", err)
+	}
+
+	return res, nil
+}
+
+func (l *linkRepository) Store(ctx context.Context, lk *domain.Link) error {
+	query := `INSERT INTO task_links (type, task_id, linked_task_id) VALUES ($1, $2, $3) RETURNING id`
+	row := l.db.QueryRowContext(ctx, query, lk.Type, lk.TaskID, lk.LinkedTaskID)
+	err := row.Scan(&lk.ID)
+	if err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM task_links WHERE id = $1`
+	_, err := l.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("delete error: %w", err)
+	}
+
+	return nil
+}
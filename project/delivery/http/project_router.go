@@ -1,37 +1,75 @@
 package router
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi"
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/atom"
+	"github.com/igkostyuk/tasktracker/internal/report"
 	"github.com/igkostyuk/tasktracker/internal/web"
 )
 
 type projectHandler struct {
 	projectUsecase domain.ProjectUsecase
+	reports        *report.Renderer
+}
+
+// Option configures the routes for project resource.
+type Option func(*projectHandler)
+
+// WithReports renders the board reports with the renderer instead of the built-in templates.
+func WithReports(rr *report.Renderer) Option {
+	return func(p *projectHandler) {
+		p.reports = rr
+	}
 }
 
 // New return routes for project resource.
-func New(us domain.ProjectUsecase) chi.Router {
+func New(us domain.ProjectUsecase, opts ...Option) chi.Router {
 	handler := &projectHandler{
 		projectUsecase: us,
+		reports:        report.Default(),
+	}
+	for _, opt := range opts {
+		opt(handler)
 	}
 	r := chi.NewRouter()
 	r.Get("/", handler.Fetch)
 	r.Post("/", handler.Store)
+	r.Get("/trash", handler.FetchDeleted)
+	r.Post("/import", handler.Import)
 	r.Route("/{projectID}", func(r chi.Router) {
 		r.Get("/", handler.GetByID)
 		r.Put("/", handler.Update)
+		r.Patch("/", handler.Patch)
 		r.Delete("/", handler.Delete)
+		r.Post("/restore", handler.Restore)
+		r.Post("/clone", handler.Clone)
+		r.Get("/export", handler.Export)
+		r.Post("/archive", handler.Archive)
+		r.Post("/unarchive", handler.Unarchive)
+		r.Get("/trash", handler.FetchTrash)
 		r.Get("/columns", handler.FetchColumns)
 		r.Post("/columns", handler.StoreColumn)
 		r.Get("/tasks", handler.FetchTasks)
+		r.Get("/tasks/export", handler.ExportTasks)
+		r.Post("/tasks/import", handler.ImportTasks)
+		r.Get("/metrics/flow", handler.FetchFlowMetrics)
+		r.Get("/reports/cfd", handler.FetchCFD)
+		r.Get("/report", handler.FetchReport)
+		r.Get("/feed.atom", handler.FetchFeed)
 	})
 
 	return r
@@ -42,16 +80,27 @@ func New(us domain.ProjectUsecase) chi.Router {
 // @Description get all projects
 // @Tags projects
 // @Produce  json
+// @Param sort query string false "sort key created_at or updated_at, prefixed with - for descending order"
+// @Param modified_since query string false "only items updated since the time" format(date-time)
+// @Param archived query bool false "list archived projects along with the active ones"
 // @Success 200 {array} domain.Project
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects [get]
 // Fetch will fetch projects.
 func (p *projectHandler) Fetch(w http.ResponseWriter, r *http.Request) {
-	projects, err := p.projectUsecase.Fetch(r.Context())
+	f, err := web.ParseFilter(r)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	f.Archived = r.URL.Query().Get("archived") == "true"
+	projects, err := p.projectUsecase.Fetch(r.Context(), f)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -65,21 +114,21 @@ func (p *projectHandler) Fetch(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "project ID" format(uuid)
 // @Success 200 {array} domain.Column
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id}/columns [get]
 // FetchColumns will fetch columns by project id.
 func (p *projectHandler) FetchColumns(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	columns, err := p.projectUsecase.FetchColumns(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -87,8 +136,7 @@ func (p *projectHandler) FetchColumns(w http.ResponseWriter, r *http.Request) {
 }
 
 func isColumnRequestValid(m *domain.Column) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -105,34 +153,34 @@ func isColumnRequestValid(m *domain.Column) (bool, error) {
 // @Param id path string true "project ID" format(uuid)
 // @Param column body domain.Column true "Add column"
 // @Success 200 {object} domain.Column
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id}/columns [post]
 // Store will store the column by given request body and Project ID.
 func (p *projectHandler) StoreColumn(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var column domain.Column
 	if err := json.NewDecoder(r.Body).Decode(&column); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	column.ProjectID = id
 	if ok, err := isColumnRequestValid(&column); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
 	if err := p.projectUsecase.StoreColumn(r.Context(), &column); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -146,27 +194,412 @@ func (p *projectHandler) StoreColumn(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "project ID" format(uuid)
 // @Success 200 {array} domain.Task
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id}/tasks [get]
 // FetchTasks will fetch tasks by project id.
 func (p *projectHandler) FetchTasks(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	tasks, err := p.projectUsecase.FetchTasks(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
 	web.Respond(w, r, tasks, http.StatusOK)
 }
 
+// taskRowHeader is the header of the tasks CSV file.
+var taskRowHeader = []string{"column", "position", "name", "description", "comments"}
+
+// ExportTasks godoc
+// @Summary Export tasks of a project as CSV
+// @Description get the tasks of the project in board order with their column name and comment count
+// @Tags tasks
+// @Produce  text/csv
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {string} string "CSV with column,position,name,description,comments header"
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/tasks/export [get]
+// ExportTasks will export tasks by project id.
+func (p *projectHandler) ExportTasks(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	rows, err := p.projectUsecase.FetchTaskRows(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	records := [][]string{taskRowHeader}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.Column, strconv.Itoa(row.Position), row.Name, row.Description, strconv.Itoa(row.Comments),
+		})
+	}
+	web.RespondCSV(w, r, records, "tasks.csv", http.StatusOK)
+}
+
+// ImportTasks godoc
+// @Summary Import tasks of a project from CSV
+// @Description append the tasks of the CSV rows to the named columns, creating the missing columns.
+// @Description The header must have column and name fields, description is optional and other fields are ignored.
+// @Description The rows that cannot be imported are reported by their number, counting the header as row 1,
+// @Description without stopping the import.
+// @Tags tasks
+// @Accept  text/csv
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.TaskImport
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/tasks/import [post]
+// ImportTasks will import tasks by project id and request body.
+func (p *projectHandler) ImportTasks(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	rows, rowErrors, err := parseTaskRows(r.Body)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	res, err := p.projectUsecase.ImportTasks(r.Context(), id, rows)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	res.Errors = append(res.Errors, rowErrors...)
+	sort.SliceStable(res.Errors, func(i, j int) bool { return res.Errors[i].Row < res.Errors[j].Row })
+	web.Respond(w, r, res, http.StatusOK)
+}
+
+// parseTaskRows reads the tasks CSV file and returns the valid rows with the errors of the invalid ones.
+func parseTaskRows(body io.Reader) ([]domain.TaskRow, []domain.RowError, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("csv header: %v: %w", err, domain.ErrBadParamInput)
+	}
+	fields := make(map[string]int, len(header))
+	for i, name := range header {
+		fields[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"column", "name"} {
+		if _, ok := fields[name]; !ok {
+			return nil, nil, fmt.Errorf("csv header has no %s field: %w", name, domain.ErrBadParamInput)
+		}
+	}
+	var (
+		rows      []domain.TaskRow
+		rowErrors []domain.RowError
+	)
+	for n := 2; ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("csv row %d: %v: %w", n, err, domain.ErrBadParamInput)
+		}
+		field := func(name string) string {
+			if i, ok := fields[name]; ok && i < len(record) {
+				return record[i]
+			}
+
+			return ""
+		}
+		// nolint:exhaustivestruct
+		row := domain.TaskRow{
+			Row:         n,
+			Column:      strings.TrimSpace(field("column")),
+			Name:        strings.TrimSpace(field("name")),
+			Description: field("description"),
+		}
+		if err := web.Validate(&row); err != nil {
+			fes := web.FieldErrors(err)
+			rowErrors = append(rowErrors, domain.RowError{Row: n, Error: web.ValidationDetail(fes), Fields: fes})
+
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+// FetchFlowMetrics godoc
+// @Summary Get flow metrics of a project
+// @Description get lead time, cycle time and time in columns of the tasks completed over a date range
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Param from query string false "start of the range, RFC 3339 time or date, defaults to 30 days before to"
+// @Param to query string false "end of the range, RFC 3339 time or inclusive date, defaults to now"
+// @Success 200 {object} domain.FlowMetrics
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/metrics/flow [get]
+// FetchFlowMetrics will fetch flow metrics by project id.
+func (p *projectHandler) FetchFlowMetrics(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	from, to, err := web.ParseRange(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	metrics, err := p.projectUsecase.FetchFlowMetrics(r.Context(), id, from, to)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, metrics, http.StatusOK)
+}
+
+// FetchCFD godoc
+// @Summary Get cumulative flow diagram of a project
+// @Description get daily task counts per column in position order over a date range
+// @Tags projects
+// @Produce  json
+// @Produce  text/csv
+// @Param  id path string true "project ID" format(uuid)
+// @Param from query string false "start of the range, RFC 3339 time or date, defaults to 30 days before to"
+// @Param to query string false "end of the range, RFC 3339 time or inclusive date, defaults to now"
+// @Param format query string false "response format json or csv" Enums(json, csv)
+// @Success 200 {object} domain.CFD
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/reports/cfd [get]
+// FetchCFD will fetch cumulative flow diagram by project id.
+func (p *projectHandler) FetchCFD(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	from, to, err := web.ParseRange(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		web.RespondError(w, r, fmt.Errorf("format %q: %w", format, domain.ErrBadParamInput))
+
+		return
+	}
+	cfd, err := p.projectUsecase.FetchCFD(r.Context(), id, from, to)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if format == "csv" {
+		web.RespondCSV(w, r, cfdRecords(&cfd), "cfd.csv", http.StatusOK)
+
+		return
+	}
+	web.Respond(w, r, cfd, http.StatusOK)
+}
+
+// cfdRecords returns the rows of dates with the counts of every column.
+func cfdRecords(cfd *domain.CFD) [][]string {
+	header := []string{"date"}
+	for _, c := range cfd.Columns {
+		header = append(header, c.Name)
+	}
+	records := [][]string{header}
+	for d, date := range cfd.Dates {
+		row := []string{date}
+		for _, c := range cfd.Columns {
+			row = append(row, strconv.Itoa(c.Counts[d]))
+		}
+		records = append(records, row)
+	}
+
+	return records
+}
+
+// FetchReport godoc
+// @Summary Get board report of a project
+// @Description render the board as sections per column in position order with the tasks in board order,
+// @Description their descriptions and most recent comments
+// @Tags projects
+// @Produce  text/markdown
+// @Produce  text/html
+// @Param  id path string true "project ID" format(uuid)
+// @Param format query string false "report format, defaults to markdown" Enums(markdown, html)
+// @Success 200 {string} string "rendered report"
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/report [get]
+// FetchReport will render board report by project id.
+func (p *projectHandler) FetchReport(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = report.FormatMarkdown
+	}
+	contentType, ok := report.ContentType(format)
+	if !ok {
+		web.RespondError(w, r, fmt.Errorf("format %q: %w", format, domain.ErrBadParamInput))
+
+		return
+	}
+	rp, err := p.projectUsecase.FetchReport(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	var buf bytes.Buffer
+	if err = p.reports.Render(&buf, format, &rp); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.RespondRaw(w, r, buf.Bytes(), contentType, http.StatusOK)
+}
+
+// FetchFeed godoc
+// @Summary Get activity feed of a project
+// @Description get the most recent task creations, moves between columns and new comments as an Atom feed
+// @Tags projects
+// @Produce  application/atom+xml
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {string} string "Atom feed"
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/feed.atom [get]
+// FetchFeed will fetch activity feed by project id.
+func (p *projectHandler) FetchFeed(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	pa, err := p.projectUsecase.FetchActivity(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	feed := activityFeed(requestURL(r), &pa)
+	var buf bytes.Buffer
+	if err = feed.Write(&buf); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.RespondRaw(w, r, buf.Bytes(), atom.ContentType, http.StatusOK)
+}
+
+// requestURL returns the absolute URL of the request as seen by the client.
+func requestURL(r *http.Request) *url.URL {
+	u := *r.URL
+	u.Scheme = "http"
+	if r.TLS != nil {
+		u.Scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		u.Scheme = proto
+	}
+	u.Host = r.Host
+	u.RawQuery = ""
+
+	return &u
+}
+
+// activityFeed returns the feed of the activity, the entries link to the tasks relative to the feed at self.
+func activityFeed(self *url.URL, pa *domain.ProjectActivity) atom.Feed {
+	updated := pa.Project.UpdatedAt
+	for _, a := range pa.Activities {
+		if a.UpdatedAt.After(updated) {
+			updated = a.UpdatedAt
+		}
+	}
+	feed := atom.New(atom.ID(pa.Project.ID), pa.Project.Name, updated)
+	feed.Links = []atom.Link{{Rel: "self", Type: atom.ContentType, Href: self.String()}}
+	for n := range pa.Activities {
+		a := &pa.Activities[n]
+		entry := atom.Entry{
+			ID:        atom.ID(a.ID),
+			Title:     activityTitle(a),
+			Published: atom.Time(a.CreatedAt),
+			Updated:   atom.Time(a.UpdatedAt),
+			Links: []atom.Link{{
+				Rel:  "alternate",
+				Type: "application/json",
+				Href: self.ResolveReference(&url.URL{Path: "../../tasks/" + a.Task.ID.String()}).String(),
+			}},
+			Summary:  nil,
+			Category: &atom.Category{Term: string(a.Kind)},
+		}
+		switch {
+		case a.Comment != nil:
+			entry.Summary = &atom.Text{Type: "text", Body: a.Comment.Text}
+		case a.Kind == domain.ActivityTaskCreated && a.Task.Description != "":
+			entry.Summary = &atom.Text{Type: "text", Body: a.Task.Description}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
+
+func activityTitle(a *domain.Activity) string {
+	switch a.Kind {
+	case domain.ActivityTaskMoved:
+		from := ""
+		if a.FromColumn != nil {
+			from = a.FromColumn.Name
+		}
+
+		return fmt.Sprintf("%s moved from %s to %s", a.Task.Name, from, a.Column.Name)
+	case domain.ActivityCommentAdded:
+		return fmt.Sprintf("New comment on %s", a.Task.Name)
+	default:
+		return fmt.Sprintf("%s created in %s", a.Task.Name, a.Column.Name)
+	}
+}
+
 // GetByID godoc
 // @Summary Show a project
 // @Description get project by id
@@ -174,21 +607,21 @@ func (p *projectHandler) FetchTasks(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "project ID" format(uuid)
 // @Success 200 {object} domain.Project
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id} [get]
 // GetByID will get project by given id.
 func (p *projectHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	project, err := p.projectUsecase.GetByID(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -196,8 +629,7 @@ func (p *projectHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func isRequestValid(m *domain.Project) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -212,30 +644,175 @@ func isRequestValid(m *domain.Project) (bool, error) {
 // @Accept  json
 // @Produce  json
 // @Param project body domain.Project true "Add project"
+// @Param template_id query string false "board template to create the columns from" format(uuid)
 // @Success 200 {object} domain.Project
-// @Failure 400 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects [post]
 // Store will store the project by given request body.
 func (p *projectHandler) Store(w http.ResponseWriter, r *http.Request) {
 	var project domain.Project
 	if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	if ok, err := isRequestValid(&project); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if templateID := r.URL.Query().Get("template_id"); templateID != "" {
+		p.storeFromTemplate(w, r, &project, templateID)
 
 		return
 	}
 	if err := p.projectUsecase.Store(r.Context(), &project); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+
+	web.Respond(w, r, project, http.StatusOK)
+}
+
+func (p *projectHandler) storeFromTemplate(w http.ResponseWriter, r *http.Request, pr *domain.Project, tid string) {
+	templateID, err := uuid.Parse(tid)
+	if err != nil {
+		web.RespondError(w, r, fmt.Errorf("template_id: %w", domain.ErrBadParamInput))
+
+		return
+	}
+	if err := p.projectUsecase.StoreFromTemplate(r.Context(), pr, templateID); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, pr, http.StatusOK)
+}
+
+func isCloneRequestValid(m *domain.CloneOptions) (bool, error) {
+	err := web.Validate(m)
+	if err != nil {
+		return false, fmt.Errorf("validation: %w", err)
+	}
+
+	return true, nil
+}
+
+// Clone godoc
+// @Summary Clone a project
+// @Description copy the columns and optionally the tasks and comments of the project into a new project
+// @Tags projects
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Param options body domain.CloneOptions true "Clone options"
+// @Success 200 {object} domain.Project
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/clone [post]
+// Clone will clone the project by given id and request body.
+func (p *projectHandler) Clone(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	var opts domain.CloneOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	if ok, err := isCloneRequestValid(&opts); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	project, err := p.projectUsecase.Clone(r.Context(), id, opts)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, project, http.StatusOK)
+}
+
+// Export godoc
+// @Summary Export a project
+// @Description get a versioned document of the project with its ordered columns, tasks and comments
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.Export
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/export [get]
+// Export will export the project by given id.
+func (p *projectHandler) Export(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	ex, err := p.projectUsecase.Export(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "project-"+id.String()+".json"))
+	web.Respond(w, r, ex, http.StatusOK)
+}
+
+func isImportRequestValid(m *domain.Export) (bool, error) {
+	err := web.Validate(m)
+	if err != nil {
+		return false, fmt.Errorf("validation: %w", err)
+	}
+
+	return true, nil
+}
+
+// Import godoc
+// @Summary Import a project
+// @Description create a project with new ids from an export document
+// @Tags projects
+// @Accept  json
+// @Produce  json
+// @Param export body domain.Export true "Export document"
+// @Success 200 {object} domain.Project
+// @Failure 400 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/import [post]
+// Import will import the project by given request body.
+func (p *projectHandler) Import(w http.ResponseWriter, r *http.Request) {
+	var ex domain.Export
+	if err := json.NewDecoder(r.Body).Decode(&ex); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	if ok, err := isImportRequestValid(&ex); !ok {
+		web.RespondError(w, r, err)
 
 		return
 	}
+	project, err := p.projectUsecase.Import(r.Context(), &ex)
+	if err != nil {
+		web.RespondError(w, r, err)
 
+		return
+	}
 	web.Respond(w, r, project, http.StatusOK)
 }
 
@@ -248,75 +825,250 @@ func (p *projectHandler) Store(w http.ResponseWriter, r *http.Request) {
 // @Param  id path string true "project ID"
 // @Param project body domain.Project true "Update project"
 // @Success 200 {object} domain.Project
-// @Failure 400 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id} [put]
 // Update will update the project by given request body.
 func (p *projectHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var project domain.Project
 	if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	project.ID = id
+	if ok, err := isRequestValid(&project); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := p.projectUsecase.Update(r.Context(), &project); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+
+	web.Respond(w, r, project, http.StatusOK)
+}
+
+// Patch godoc
+// @Summary Patch a project
+// @Description update the project by a JSON merge patch applied on top of the stored project
+// @Tags projects
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Param patch body domain.Project true "JSON merge patch of the project"
+// @Success 200 {object} domain.Project
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 415 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id} [patch]
+// Patch will update the project by the JSON merge patch of the request body.
+func (p *projectHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if !web.IsMergePatch(r) {
+		web.RespondRequestError(w, r, web.ErrMergePatchType, http.StatusUnsupportedMediaType)
+
+		return
+	}
+	project, err := p.projectUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := web.MergePatch(&project, r.Body); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	project.ID = id
 	if ok, err := isRequestValid(&project); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
 	if err := p.projectUsecase.Update(r.Context(), &project); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
+	// Read the project back to respond with the timestamps set by the database.
+	project, err = p.projectUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
+		return
+	}
 	web.Respond(w, r, project, http.StatusOK)
 }
 
 // Delete godoc
 // @Summary Delete a project
-// @Description Delete by project ID
+// @Description Move the project with its columns and tasks to the trash
 // @Tags projects
 // @Produce  json
 // @Param  id path string true "project ID"
 // @Success 204 "it's ok"
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /projects/{id} [delete]
 // Delete will delete project by given param.
 func (p *projectHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	if err := p.projectUsecase.Delete(r.Context(), id); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func getStatusCode(err error) int {
-	switch {
-	case errors.Is(err, domain.ErrNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrConflict):
-		return http.StatusConflict
-	case errors.Is(err, domain.ErrUnique):
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
+// FetchDeleted godoc
+// @Summary Get deleted projects
+// @Description get projects in the trash, the latest deleted first
+// @Tags projects
+// @Produce  json
+// @Success 200 {array} domain.Project
+// @Failure 500 {object} web.Problem
+// @Router /projects/trash [get]
+// FetchDeleted will fetch deleted projects.
+func (p *projectHandler) FetchDeleted(w http.ResponseWriter, r *http.Request) {
+	projects, err := p.projectUsecase.FetchDeleted(r.Context())
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, projects, http.StatusOK)
+}
+
+// FetchTrash godoc
+// @Summary Get trash of a project
+// @Description get columns and tasks deleted from the project, the latest deleted first
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.Trash
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/trash [get]
+// FetchTrash will fetch deleted columns and tasks by project id.
+func (p *projectHandler) FetchTrash(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	trash, err := p.projectUsecase.FetchTrash(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, trash, http.StatusOK)
+}
+
+// Restore godoc
+// @Summary Restore a project
+// @Description restore a deleted project with the columns, tasks and comments deleted with it
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.Project
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/restore [post]
+// Restore will restore the deleted project by given param.
+func (p *projectHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	project, err := p.projectUsecase.Restore(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, project, http.StatusOK)
+}
+
+// Archive godoc
+// @Summary Archive a project
+// @Description make the board of the project read-only and hide the project from the default listing
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.Project
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/archive [post]
+// Archive will archive the project by given param.
+func (p *projectHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
 	}
+	project, err := p.projectUsecase.Archive(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, project, http.StatusOK)
+}
+
+// Unarchive godoc
+// @Summary Unarchive a project
+// @Description make the board of the archived project writable again
+// @Tags projects
+// @Produce  json
+// @Param  id path string true "project ID" format(uuid)
+// @Success 200 {object} domain.Project
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /projects/{id}/unarchive [post]
+// Unarchive will unarchive the project by given param.
+func (p *projectHandler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "projectID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	project, err := p.projectUsecase.Unarchive(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, project, http.StatusOK)
 }
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
 	mocks "github.com/igkostyuk/tasktracker/domain/mock"
@@ -26,7 +29,7 @@ func TestFetch(t *testing.T) {
 		want := []domain.Project{{Name: "1", Description: "testDescription"}, {Name: "2", Description: "testDescription2"}}
 		// nolint:exhaustivestruct
 		mockedProjectUsecase := &mocks.ProjectUsecaseMock{
-			FetchFunc: func(ctx context.Context) ([]domain.Project, error) {
+			FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 				return want, nil
 			},
 		}
@@ -49,13 +52,13 @@ func TestFetch(t *testing.T) {
 
 		// nolint:exhaustivestruct
 		mockedProjectUsecase := &mocks.ProjectUsecaseMock{
-			FetchFunc: func(ctx context.Context) ([]domain.Project, error) {
+			FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 				return nil, mockError
 			},
 		}
 		request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 		is.NoErr(err)
-		checkError(t, mockedProjectUsecase, request, http.StatusInternalServerError, mockError.Error())
+		checkError(t, mockedProjectUsecase, request, http.StatusInternalServerError, web.InternalMessage)
 		is.Equal(len(mockedProjectUsecase.FetchCalls()), 1)
 	})
 }
@@ -100,7 +103,7 @@ func TestFetchColumnsErrors(t *testing.T) {
 			name:      "500",
 			path:      fmt.Sprintf("/%s/columns", validUUIDString),
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 		{
@@ -193,7 +196,7 @@ func TestStoreColumnErrors(t *testing.T) {
 			path:      fmt.Sprintf("/%s/columns", validUUIDString),
 			column:    `{"name":"testName"}`,
 			code:      http.StatusBadRequest,
-			message:   "validation: Key: 'Column.Status' Error:Field validation for 'Status' failed on the 'required' tag",
+			message:   "validation: status is required",
 			mockError: nil,
 		},
 		{
@@ -201,7 +204,7 @@ func TestStoreColumnErrors(t *testing.T) {
 			path:      fmt.Sprintf("/%s/columns", validUUIDString),
 			column:    `{"status":"testStatus"}`,
 			code:      http.StatusBadRequest,
-			message:   "validation: Key: 'Column.Name' Error:Field validation for 'Name' failed on the 'required' tag",
+			message:   "validation: name is required",
 			mockError: nil,
 		},
 		{
@@ -213,10 +216,10 @@ func TestStoreColumnErrors(t *testing.T) {
 			mockError: nil,
 		},
 		{
-			name:      "400",
+			name:      "409",
 			path:      fmt.Sprintf("/%s/columns", validUUIDString),
 			column:    `{"name":"testName","status":"testStatus"}`,
-			code:      http.StatusBadRequest,
+			code:      http.StatusConflict,
 			message:   domain.ErrUnique.Error(),
 			mockError: domain.ErrUnique,
 		},
@@ -233,7 +236,7 @@ func TestStoreColumnErrors(t *testing.T) {
 			path:      fmt.Sprintf("/%s/columns", validUUIDString),
 			column:    `{"name":"testName","status":"testStatus"}`,
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 	}
@@ -305,7 +308,7 @@ func TestFetchTasksErrors(t *testing.T) {
 			name:      "500",
 			path:      fmt.Sprintf("/%s/tasks", validUUIDString),
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 		{
@@ -391,7 +394,7 @@ func TestGetByIDErrors(t *testing.T) {
 			name:      "500",
 			path:      fmt.Sprintf("/%s", validUUIDString),
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 		{
@@ -475,18 +478,17 @@ func TestStoreErrors(t *testing.T) {
 		mockError error
 	}{
 		{
-			name:    "400",
-			project: `{"name":"testName"}`,
-			code:    http.StatusBadRequest,
-			message: "validation: Key: 'Project.Description'" +
-				" Error:Field validation for 'Description' failed on the 'required' tag",
+			name:      "400",
+			project:   `{"name":"testName"}`,
+			code:      http.StatusBadRequest,
+			message:   "validation: description is required",
 			mockError: nil,
 		},
 		{
 			name:      "400",
 			project:   `{"description":"testDescription"}`,
 			code:      http.StatusBadRequest,
-			message:   "validation: Key: 'Project.Name' Error:Field validation for 'Name' failed on the 'required' tag",
+			message:   "validation: name is required",
 			mockError: nil,
 		},
 		{
@@ -507,7 +509,7 @@ func TestStoreErrors(t *testing.T) {
 			name:      "500",
 			project:   `{"name":"testName","description":"testDescription"}`,
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 	}
@@ -578,12 +580,11 @@ func TestUpdateErrors(t *testing.T) {
 		mockError error
 	}{
 		{
-			name:    "400",
-			path:    fmt.Sprintf("/%s", validUUIDString),
-			project: `{"name":"testName"}`,
-			code:    http.StatusBadRequest,
-			message: "validation: Key: 'Project.Description'" +
-				" Error:Field validation for 'Description' failed on the 'required' tag",
+			name:      "400",
+			path:      fmt.Sprintf("/%s", validUUIDString),
+			project:   `{"name":"testName"}`,
+			code:      http.StatusBadRequest,
+			message:   "validation: description is required",
 			mockError: nil,
 		},
 		{
@@ -591,7 +592,7 @@ func TestUpdateErrors(t *testing.T) {
 			path:      fmt.Sprintf("/%s", validUUIDString),
 			project:   `{"description":"testDescription"}`,
 			code:      http.StatusBadRequest,
-			message:   "validation: Key: 'Project.Name' Error:Field validation for 'Name' failed on the 'required' tag",
+			message:   "validation: name is required",
 			mockError: nil,
 		},
 		{
@@ -623,7 +624,7 @@ func TestUpdateErrors(t *testing.T) {
 			path:      fmt.Sprintf("/%s", validUUIDString),
 			project:   `{"name":"testName","description":"testDescription"}`,
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 	}
@@ -657,6 +658,89 @@ func TestUpdateErrors(t *testing.T) {
 	}
 }
 
+//nolint:funlen
+func TestPatch(t *testing.T) {
+	id, _ := uuid.Parse(validUUIDString)
+	tt := []struct {
+		name        string
+		contentType string
+		patch       string
+		code        int
+		want        domain.Project
+		updates     int
+	}{
+		{
+			name:        "keeps the fields left out of the patch",
+			contentType: web.MergePatchType,
+			patch:       `{"name":"patched"}`,
+			code:        http.StatusOK,
+			// nolint:exhaustivestruct
+			want:    domain.Project{ID: id, Name: "patched", Description: "stored"},
+			updates: 1,
+		},
+		{
+			name:        "validates the patched project",
+			contentType: web.MergePatchType,
+			patch:       `{"description":null}`,
+			code:        http.StatusBadRequest,
+			updates:     0,
+		},
+		{
+			name:        "rejects an invalid patch",
+			contentType: web.MergePatchType,
+			patch:       `{"name":1}`,
+			code:        http.StatusUnprocessableEntity,
+			updates:     0,
+		},
+		{
+			name:        "rejects other content types",
+			contentType: "text/plain",
+			patch:       `{"name":"patched"}`,
+			code:        http.StatusUnsupportedMediaType,
+			updates:     0,
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			stored := domain.Project{Name: "stored", Description: "stored"}
+			// nolint:exhaustivestruct
+			mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+					p := stored
+					p.ID = id
+
+					return p, nil
+				},
+				UpdateFunc: func(ctx context.Context, pr *domain.Project) error {
+					stored = *pr
+
+					return nil
+				},
+			}
+			path := fmt.Sprintf("/%s", validUUIDString)
+			request, err := http.NewRequestWithContext(
+				context.Background(), http.MethodPatch, path, strings.NewReader(tc.patch),
+			)
+			is.NoErr(err)
+			request.Header.Set("Content-Type", tc.contentType)
+			response := httptest.NewRecorder()
+
+			projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+			is.Equal(response.Code, tc.code)
+			is.Equal(len(mockedProjectUsecase.UpdateCalls()), tc.updates)
+			if tc.code != http.StatusOK {
+				return
+			}
+			var got domain.Project
+			is.NoErr(json.NewDecoder(response.Body).Decode(&got))
+			is.Equal(got, tc.want)
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	is := helper.New(t)
 	var calledUUID uuid.UUID
@@ -692,7 +776,7 @@ func TestDeleteErrors(t *testing.T) {
 			name:      "500",
 			path:      fmt.Sprintf("/%s", validUUIDString),
 			code:      http.StatusInternalServerError,
-			message:   domain.ErrInternalServerError.Error(),
+			message:   web.InternalMessage,
 			mockError: domain.ErrInternalServerError,
 		},
 		{
@@ -737,6 +821,243 @@ func TestDeleteErrors(t *testing.T) {
 	}
 }
 
+func TestFetchFlowMetrics(t *testing.T) {
+	t.Run("returns metrics", func(t *testing.T) {
+		is := helper.New(t)
+		want := domain.FlowMetrics{Completed: 1, LeadTime: domain.Percentiles{P50: 1, P85: 2, P95: 3}}
+		var from, to time.Time
+		// nolint:exhaustivestruct
+		mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+			FetchFlowMetricsFunc: func(ctx context.Context, id uuid.UUID, f, t time.Time) (domain.FlowMetrics, error) {
+				from, to = f, t
+
+				return want, nil
+			},
+		}
+		path := fmt.Sprintf("/%s/metrics/flow?from=2026-10-01&to=2026-10-07", validUUIDString)
+		request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+		response := httptest.NewRecorder()
+
+		projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+		var got domain.FlowMetrics
+		err := json.NewDecoder(response.Body).Decode(&got)
+		is.NoErr(err)
+		is.Equal(response.Code, http.StatusOK)
+		is.Equal(want, got)
+		is.Equal(from, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+		is.Equal(to, time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC))
+	})
+	t.Run("returns bad range error", func(t *testing.T) {
+		is := helper.New(t)
+		// nolint:exhaustivestruct
+		mockedProjectUsecase := &mocks.ProjectUsecaseMock{}
+		path := fmt.Sprintf("/%s/metrics/flow?from=2026-10-07&to=2026-10-01", validUUIDString)
+		request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+		is.NoErr(err)
+		checkError(t, mockedProjectUsecase, request, http.StatusBadRequest,
+			"from must be before to: "+domain.ErrBadParamInput.Error())
+	})
+}
+
+func TestFetchCFDCSV(t *testing.T) {
+	is := helper.New(t)
+	// nolint:exhaustivestruct
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		FetchCFDFunc: func(ctx context.Context, id uuid.UUID, from, to time.Time) (domain.CFD, error) {
+			return domain.CFD{
+				Dates: []string{"2026-10-01", "2026-10-02"},
+				Columns: []domain.CFDSeries{
+					{Name: "todo", Counts: []int{2, 1}},
+					{Name: "done", Counts: []int{0, 1}},
+				},
+			}, nil
+		},
+	}
+	path := fmt.Sprintf("/%s/reports/cfd?format=csv", validUUIDString)
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+	response := httptest.NewRecorder()
+
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	is.Equal(response.Header().Get("Content-Type"), "text/csv; charset=utf-8")
+	is.Equal(response.Body.String(), "date,todo,done\n2026-10-01,2,0\n2026-10-02,1,1\n")
+}
+
+//nolint:exhaustivestruct
+func TestStoreFromTemplate(t *testing.T) {
+	is := helper.New(t)
+	want := domain.Project{Name: "testName", Description: "testDescription"}
+
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		StoreFromTemplateFunc: func(ctx context.Context, pr *domain.Project, templateID uuid.UUID) error {
+			return nil
+		},
+	}
+	jsonData, err := json.Marshal(want)
+	is.NoErr(err)
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/?template_id="+validUUIDString, bytes.NewBuffer(jsonData))
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	calls := mockedProjectUsecase.StoreFromTemplateCalls()
+	is.Equal(len(calls), 1)
+	is.Equal(calls[0].TemplateID.String(), validUUIDString)
+
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/?template_id=invalid", bytes.NewBuffer(jsonData))
+	is.NoErr(err)
+	checkError(t, mockedProjectUsecase, request, http.StatusBadRequest, "template_id: given param is not valid")
+}
+
+//nolint:exhaustivestruct
+func TestClone(t *testing.T) {
+	is := helper.New(t)
+	want := domain.Project{ID: uuid.New(), Name: "board (copy)"}
+
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		CloneFunc: func(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error) {
+			if opts.Comments && !opts.Tasks {
+				return domain.Project{}, domain.ErrBadParamInput
+			}
+
+			return want, nil
+		},
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/"+validUUIDString+"/clone", strings.NewReader(`{"tasks":true,"comments":true}`))
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	var got domain.Project
+	is.NoErr(json.NewDecoder(response.Body).Decode(&got))
+	is.Equal(want.ID, got.ID)
+	calls := mockedProjectUsecase.CloneCalls()
+	is.Equal(len(calls), 1)
+	is.Equal(calls[0].Opts, domain.CloneOptions{Tasks: true, Comments: true})
+
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/"+validUUIDString+"/clone", strings.NewReader(`{"comments":true}`))
+	is.NoErr(err)
+	checkError(t, mockedProjectUsecase, request, http.StatusBadRequest, domain.ErrBadParamInput.Error())
+}
+
+//nolint:exhaustivestruct
+func TestExportImport(t *testing.T) {
+	is := helper.New(t)
+	taskID := uuid.New()
+	want := domain.Export{
+		Version: domain.ExportVersion,
+		Project: domain.ExportedProject{Name: "board", Description: "description"},
+		Columns: []domain.ExportedColumn{{
+			Name:     "todo",
+			Status:   "todo",
+			Category: domain.CategoryTodo,
+			Tasks:    []domain.ExportedTask{{ID: taskID, Name: "task", Comments: []domain.ExportedComment{}}},
+		}},
+	}
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		ExportFunc: func(ctx context.Context, id uuid.UUID) (domain.Export, error) {
+			return want, nil
+		},
+		ImportFunc: func(ctx context.Context, ex *domain.Export) (domain.Project, error) {
+			return domain.Project{ID: uuid.New(), Name: ex.Project.Name}, nil
+		},
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/"+validUUIDString+"/export", nil)
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+	is.Equal(response.Code, http.StatusOK)
+	is.Equal(response.Header().Get("Content-Disposition"), `attachment; filename="project-`+validUUIDString+`.json"`)
+
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodPost, "/import", response.Body)
+	is.NoErr(err)
+	response = httptest.NewRecorder()
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+	is.Equal(response.Code, http.StatusOK)
+	calls := mockedProjectUsecase.ImportCalls()
+	is.Equal(len(calls), 1)
+	is.Equal(*calls[0].Ex, want)
+
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodPost, "/import",
+		strings.NewReader(`{"version":1,"project":{"name":"board"},"columns":[]}`))
+	is.NoErr(err)
+	response = httptest.NewRecorder()
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+	is.Equal(response.Code, http.StatusBadRequest)
+	is.Equal(len(mockedProjectUsecase.ImportCalls()), 1)
+}
+
+//nolint:exhaustivestruct
+func TestExportTasks(t *testing.T) {
+	is := helper.New(t)
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		FetchTaskRowsFunc: func(ctx context.Context, id uuid.UUID) ([]domain.TaskRow, error) {
+			return []domain.TaskRow{{Column: "todo", Position: 0, Name: "task", Description: "a, b", Comments: 2}}, nil
+		},
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+		"/"+validUUIDString+"/tasks/export", nil)
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	is.Equal(response.Body.String(), "column,position,name,description,comments\ntodo,0,task,\"a, b\",2\n")
+}
+
+//nolint:exhaustivestruct
+func TestImportTasks(t *testing.T) {
+	is := helper.New(t)
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		ImportTasksFunc: func(ctx context.Context, id uuid.UUID, rows []domain.TaskRow) (domain.TaskImport, error) {
+			return domain.TaskImport{
+				Imported: len(rows) - 1,
+				Tasks:    []domain.Task{},
+				Errors:   []domain.RowError{{Row: rows[len(rows)-1].Row, Error: "some error"}},
+			}, nil
+		},
+	}
+	body := "name,Column,position\nfirst,todo,0\n,todo,1\nsecond,done\nthird,done,2\n"
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/"+validUUIDString+"/tasks/import", strings.NewReader(body))
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+
+	projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	var got domain.TaskImport
+	is.NoErr(json.NewDecoder(response.Body).Decode(&got))
+	is.Equal(got.Imported, 2)
+	is.Equal(len(got.Errors), 2)
+	is.Equal(got.Errors[0], domain.RowError{
+		Row:    3,
+		Error:  "validation: name is required",
+		Fields: []web.FieldError{{Field: "name", Rule: "required", Message: "is required"}},
+	})
+	is.Equal(got.Errors[1], domain.RowError{Row: 5, Error: "some error"})
+	calls := mockedProjectUsecase.ImportTasksCalls()
+	is.Equal(len(calls), 1)
+	is.Equal(calls[0].Rows[1], domain.TaskRow{Row: 4, Column: "done", Name: "second"})
+
+	request, err = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/"+validUUIDString+"/tasks/import", strings.NewReader("title,description\nfirst,todo\n"))
+	is.NoErr(err)
+	checkError(t, mockedProjectUsecase, request, http.StatusBadRequest,
+		"csv header has no column field: "+domain.ErrBadParamInput.Error())
+}
+
 func checkError(t *testing.T, mockedUsecase domain.ProjectUsecase, request *http.Request, code int, message string) {
 	t.Helper()
 	is := helper.New(t)
@@ -745,12 +1066,107 @@ func checkError(t *testing.T, mockedUsecase domain.ProjectUsecase, request *http
 
 	projectDelivery.New(mockedUsecase).ServeHTTP(response, request)
 
-	want := web.HTTPError{Code: code, Message: message}
-
-	var got web.HTTPError
+	var got web.Problem
 	err := json.NewDecoder(response.Body).Decode(&got)
 
 	is.NoErr(err)
 	is.Equal(response.Code, code)
-	is.Equal(want, got)
+	is.Equal(response.Header().Get("Content-Type"), web.ProblemType)
+	is.Equal(got.Status, code)
+	is.Equal(got.Detail, message)
+}
+
+//nolint:exhaustivestruct
+func TestFetchReport(t *testing.T) {
+	is := helper.New(t)
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		FetchReportFunc: func(ctx context.Context, id uuid.UUID) (domain.Report, error) {
+			return domain.Report{
+				Project: domain.Project{ID: id, Name: "board"},
+				Columns: []domain.ReportColumn{{Column: domain.Column{Name: "todo"}}},
+			}, nil
+		},
+	}
+	tests := []struct {
+		name        string
+		query       string
+		code        int
+		contentType string
+		body        string
+	}{
+		{"markdown by default", "", http.StatusOK, "text/markdown; charset=utf-8", "# board\n"},
+		{"html", "?format=html", http.StatusOK, "text/html; charset=utf-8", "<h1>board</h1>"},
+		{"unknown format", "?format=pdf", http.StatusBadRequest, web.ProblemType, "given param is not valid"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := is.New(t)
+			request, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+				"/"+validUUIDString+"/report"+tc.query, nil)
+			is.NoErr(err)
+			response := httptest.NewRecorder()
+
+			projectDelivery.New(mockedProjectUsecase).ServeHTTP(response, request)
+
+			is.Equal(response.Code, tc.code)
+			is.Equal(response.Header().Get("Content-Type"), tc.contentType)
+			is.True(strings.Contains(response.Body.String(), tc.body))
+		})
+	}
+	is.Equal(len(mockedProjectUsecase.FetchReportCalls()), 2)
+}
+
+//nolint:exhaustivestruct
+func TestFetchFeed(t *testing.T) {
+	is := helper.New(t)
+	taskID, commentID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	mockedProjectUsecase := &mocks.ProjectUsecaseMock{
+		FetchActivityFunc: func(ctx context.Context, id uuid.UUID) (domain.ProjectActivity, error) {
+			return domain.ProjectActivity{
+				Project: domain.Project{ID: id, Name: "board", UpdatedAt: at.Add(-time.Hour)},
+				Activities: []domain.Activity{{
+					ID:        commentID,
+					Kind:      domain.ActivityCommentAdded,
+					Task:      domain.Task{ID: taskID, Name: "task"},
+					Comment:   &domain.Comment{Text: "note"},
+					CreatedAt: at,
+					UpdatedAt: at,
+				}},
+			}, nil
+		},
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+		"http://example.com/v1/projects/"+validUUIDString+"/feed.atom", nil)
+	is.NoErr(err)
+	response := httptest.NewRecorder()
+
+	r := chi.NewRouter()
+	r.Mount("/v1/projects", projectDelivery.New(mockedProjectUsecase))
+	r.ServeHTTP(response, request)
+
+	is.Equal(response.Code, http.StatusOK)
+	is.Equal(response.Header().Get("Content-Type"), "application/atom+xml; charset=utf-8")
+	var feed struct {
+		ID      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Title   string `xml:"title"`
+			Updated string `xml:"updated"`
+			Summary string `xml:"summary"`
+			Link    struct {
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+		} `xml:"entry"`
+	}
+	is.NoErr(xml.NewDecoder(response.Body).Decode(&feed))
+	is.Equal(feed.ID, "urn:uuid:"+validUUIDString)
+	is.Equal(feed.Updated, "2026-10-01T12:00:00Z")
+	is.Equal(len(feed.Entries), 1)
+	is.Equal(feed.Entries[0].ID, "urn:uuid:"+commentID.String())
+	is.Equal(feed.Entries[0].Title, "New comment on task")
+	is.Equal(feed.Entries[0].Summary, "note")
+	is.Equal(feed.Entries[0].Link.Href, "http://example.com/v1/tasks/"+taskID.String())
 }
@@ -3,11 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	store "github.com/igkostyuk/tasktracker/store/postgres"
 )
 
 type projectRepository struct {
@@ -19,9 +22,8 @@ func New(db *sql.DB) domain.ProjectRepository {
 	return &projectRepository{db: db}
 }
 
-func (p *projectRepository) Fetch(ctx context.Context) ([]domain.Project, error) {
-	query := `SELECT id,name,description FROM projects ORDER BY name`
-	rows, err := p.db.QueryContext(ctx, query)
+func (p *projectRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Project, error) {
+	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -29,14 +31,23 @@ func (p *projectRepository) Fetch(ctx context.Context) ([]domain.Project, error)
 	result := make([]domain.Project, 0)
 	for rows.Next() {
 		t := domain.Project{}
+		var labels []byte
 		err = rows.Scan(
 			&t.ID,
 			&t.Name,
 			&t.Description,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.ArchivedAt,
+			&t.DeletedAt,
+			&labels,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("rows scan error: %w", err)
 		}
+		if err = json.Unmarshal(labels, &t.Labels); err != nil {
+			return nil, fmt.Errorf("unmarshal labels: %w", err)
+		}
 		result = append(result, t)
 	}
 
@@ -47,29 +58,85 @@ func (p *projectRepository) Fetch(ctx context.Context) ([]domain.Project, error)
 	return result, nil
 }
 
+func (p *projectRepository) Fetch(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
+	// nolint:gosec // order by is built from the whitelisted sort keys.
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE deleted_at IS NULL AND updated_at >= $1 AND (archived_at IS NULL OR $2)
+	ORDER BY ` + store.OrderBy(f, "name")
+
+	return p.fetch(ctx, query, f.ModifiedSince, f.Archived)
+}
+
+func (p *projectRepository) FetchDeleted(ctx context.Context) ([]domain.Project, error) {
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+
+	return p.fetch(ctx, query)
+}
+
 func (p *projectRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Project, error) {
 	row := p.db.QueryRowContext(ctx, query, args...)
 	res := domain.Project{}
-	err := row.Scan(&res.ID, &res.Name, &res.Description)
+	var labels []byte
+	err := row.Scan(&res.ID, &res.Name, &res.Description, &res.CreatedAt, &res.UpdatedAt, &res.ArchivedAt,
+		&res.DeletedAt, &labels)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Project{}, fmt.Errorf("project: %w", domain.ErrNotFound)
 	}
 	if err != nil {
 		return domain.Project{}, fmt.Errorf("getOne error: %w", err)
 	}
+	if err = json.Unmarshal(labels, &res.Labels); err != nil {
+		return domain.Project{}, fmt.Errorf("unmarshal labels: %w", err)
+	}
 
 	return res, nil
 }
 
 func (p *projectRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
-	query := `SELECT id,name,description FROM projects WHERE id = $1`
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	return p.getOne(ctx, query, id)
+}
+
+func (p *projectRepository) GetByTaskID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	query := `SELECT p.id,p.name,p.description,p.created_at,p.updated_at,p.archived_at,p.deleted_at,p.labels
+	FROM projects p
+	JOIN columns c ON c.project_id = p.id JOIN tasks t ON t.colum_id = c.id
+	WHERE t.id = $1 AND p.deleted_at IS NULL`
+
+	return p.getOne(ctx, query, id)
+}
+
+func (p *projectRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE id = $1 AND deleted_at IS NOT NULL`
 
 	return p.getOne(ctx, query, id)
 }
 
 func (p *projectRepository) Update(ctx context.Context, pr *domain.Project) error {
-	query := `UPDATE projects SET name = $2,description = $3 WHERE id = $1`
-	_, err := p.db.ExecContext(ctx, query, pr.ID, pr.Name, pr.Description)
+	query := `UPDATE projects SET name = $2,description = $3 WHERE id = $1 AND deleted_at IS NULL
+	RETURNING created_at,updated_at`
+	row := p.db.QueryRowContext(ctx, query, pr.ID, pr.Name, pr.Description)
+	err := row.Scan(&pr.CreatedAt, &pr.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("project: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("update error: %w", err)
+	}
+
+	return nil
+}
+
+func (p *projectRepository) SetArchivedAt(ctx context.Context, pr *domain.Project) error {
+	query := `UPDATE projects SET archived_at = $2 WHERE id = $1 AND deleted_at IS NULL RETURNING updated_at`
+	err := p.db.QueryRowContext(ctx, query, pr.ID, pr.ArchivedAt).Scan(&pr.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("project: %w", domain.ErrNotFound)
+	}
 	if err != nil {
 		return fmt.Errorf("update error: %w", err)
 	}
@@ -78,9 +145,35 @@ func (p *projectRepository) Update(ctx context.Context, pr *domain.Project) erro
 }
 
 func (p *projectRepository) Store(ctx context.Context, a *domain.Project) error {
-	query := `INSERT INTO projects ( name, description) VALUES ($1, $2) RETURNING id`
+	query := `INSERT INTO projects ( name, description) VALUES ($1, $2) RETURNING id,created_at,updated_at`
 	row := p.db.QueryRowContext(ctx, query, a.Name, a.Description)
-	err := row.Scan(&a.ID)
+	err := row.Scan(&a.ID, &a.CreatedAt, &a.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+
+	return nil
+}
+
+// StoreWithColumns stores the project together with its columns in one transaction.
+func (p *projectRepository) StoreWithColumns(ctx context.Context, pr *domain.Project, cls []domain.Column) error {
+	err := store.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		if err := insertProject(ctx, tx, pr); err != nil {
+			return err
+		}
+		query := `INSERT INTO columns (position,name,status,category,wip_limit,project_id)
+		VALUES ( $1, $2, $3, $4, $5, $6) RETURNING id,created_at,updated_at`
+		for i := range cls {
+			cls[i].ProjectID = pr.ID
+			c := &cls[i]
+			row := tx.QueryRowContext(ctx, query, c.Position, c.Name, c.Status, c.Category, c.WIPLimit, c.ProjectID)
+			if err := row.Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+				return fmt.Errorf("insert column: %w", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("store error: %w", err)
 	}
@@ -88,12 +181,309 @@ func (p *projectRepository) Store(ctx context.Context, a *domain.Project) error
 	return nil
 }
 
+// insertProject inserts the project pr with its labels.
+func insertProject(ctx context.Context, tx *sql.Tx, pr *domain.Project) error {
+	labels, err := json.Marshal(pr.Labels)
+	if err != nil {
+		return fmt.Errorf("marshal labels: %w", err)
+	}
+	query := `INSERT INTO projects (name,description,labels) VALUES ($1, $2, $3) RETURNING id,created_at,updated_at`
+	err = tx.QueryRowContext(ctx, query, pr.Name, pr.Description, labels).Scan(&pr.ID, &pr.CreatedAt, &pr.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insert project: %w", err)
+	}
+
+	return nil
+}
+
+// Clone copies the live columns of the project with id and, depending on opts, its tasks and
+// their comments into the new project pr in one transaction.
+func (p *projectRepository) Clone(
+	ctx context.Context, id uuid.UUID, pr *domain.Project, opts domain.CloneOptions,
+) error {
+	err := store.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		if err := insertProject(ctx, tx, pr); err != nil {
+			return err
+		}
+		columns, err := cloneColumns(ctx, tx, id, pr.ID)
+		if err != nil {
+			return err
+		}
+		if !opts.Tasks {
+			return nil
+		}
+		ids, tasks, err := cloneTasks(ctx, tx, id, columns)
+		if err != nil {
+			return err
+		}
+		if !opts.Comments {
+			return nil
+		}
+
+		return cloneComments(ctx, tx, ids, tasks)
+	})
+	if err != nil {
+		return fmt.Errorf("clone error: %w", err)
+	}
+
+	return nil
+}
+
+// Import stores the project pr with the board of the export document in one transaction.
+// The columns and tasks keep the order of the document and the comments keep their timestamps.
+func (p *projectRepository) Import(ctx context.Context, pr *domain.Project, ex *domain.Export) error {
+	err := store.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		err := insertProject(ctx, tx, pr)
+		if err != nil {
+			return err
+		}
+		tasks := make(map[uuid.UUID]uuid.UUID)
+		for i, c := range ex.Columns {
+			var columnID uuid.UUID
+			query := `INSERT INTO columns (position,name,status,category,wip_limit,project_id)
+			VALUES ( $1, $2, $3, $4, $5, $6) RETURNING id`
+			err = tx.QueryRowContext(ctx, query, i, c.Name, c.Status, c.Category, c.WIPLimit, pr.ID).Scan(&columnID)
+			if err != nil {
+				return fmt.Errorf("insert column: %w", err)
+			}
+			for j, tk := range c.Tasks {
+				if tasks[tk.ID], err = importTask(ctx, tx, j, columnID, tk); err != nil {
+					return err
+				}
+			}
+		}
+		query := `UPDATE tasks SET parent_id = $2 WHERE id = $1`
+		for _, c := range ex.Columns {
+			for _, tk := range c.Tasks {
+				if tk.ParentID == nil {
+					continue
+				}
+				if _, err = tx.ExecContext(ctx, query, tasks[tk.ID], tasks[*tk.ParentID]); err != nil {
+					return fmt.Errorf("update parent: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("import error: %w", err)
+	}
+
+	return nil
+}
+
+// importTask stores the task of an export document with its comments in the column
+// at the position, records the creation transition and returns the id of the stored task.
+func importTask(ctx context.Context, tx *sql.Tx, position int, columnID uuid.UUID, tk domain.ExportedTask,
+) (uuid.UUID, error) {
+	var id uuid.UUID
+	query := `INSERT INTO tasks (position, name, description, colum_id, completed_at)
+	VALUES ( $1, $2, $3, $4, $5) RETURNING id`
+	err := tx.QueryRowContext(ctx, query, position, tk.Name, tk.Description, columnID, tk.CompletedAt).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("insert task: %w", err)
+	}
+	query = `INSERT INTO task_transitions (task_id, to_column_id, created_at) VALUES ($1, $2, NOW())`
+	if _, err = tx.ExecContext(ctx, query, id, columnID); err != nil {
+		return uuid.Nil, fmt.Errorf("insert transition: %w", err)
+	}
+	query = `INSERT INTO comments (text, task_id, created_at) VALUES ( $1, $2, $3)`
+	for _, cm := range tk.Comments {
+		if _, err = tx.ExecContext(ctx, query, cm.Text, id, cm.CreatedAt); err != nil {
+			return uuid.Nil, fmt.Errorf("insert comment: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+// cloneColumns copies the live columns of the project from into the project to
+// and returns the ids of the copies by the ids of the originals.
+func cloneColumns(ctx context.Context, tx *sql.Tx, from, to uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	ids, err := selectIDs(ctx, tx, `SELECT id FROM columns WHERE project_id = $1 AND deleted_at IS NULL
+	ORDER BY position`, from)
+	if err != nil {
+		return nil, fmt.Errorf("select columns: %w", err)
+	}
+	query := `INSERT INTO columns (position,name,status,category,wip_limit,project_id)
+	SELECT $3,name,status,category,wip_limit,$2 FROM columns WHERE id = $1 RETURNING id`
+	columns := make(map[uuid.UUID]uuid.UUID, len(ids))
+	for i, id := range ids {
+		var newID uuid.UUID
+		if err = tx.QueryRowContext(ctx, query, id, to, i).Scan(&newID); err != nil {
+			return nil, fmt.Errorf("insert column: %w", err)
+		}
+		columns[id] = newID
+	}
+
+	return columns, nil
+}
+
+// cloneTasks copies the live tasks of the project from into the copied columns keeping
+// the parent tasks, records the creation transitions of the copies and returns
+// the ids of the originals with the ids of the copies by the ids of the originals.
+func cloneTasks(ctx context.Context, tx *sql.Tx, from uuid.UUID, columns map[uuid.UUID]uuid.UUID,
+) ([]uuid.UUID, map[uuid.UUID]uuid.UUID, error) {
+	query := `SELECT t.id,t.colum_id,t.parent_id FROM tasks t JOIN columns c ON t.colum_id = c.id
+	WHERE c.project_id = $1 AND c.deleted_at IS NULL AND t.deleted_at IS NULL ORDER BY t.position`
+	rows, err := tx.QueryContext(ctx, query, from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("select tasks: %w", err)
+	}
+	defer rows.Close()
+	var (
+		tks []domain.Task
+		ids []uuid.UUID
+	)
+	for rows.Next() {
+		tk := domain.Task{}
+		if err = rows.Scan(&tk.ID, &tk.ColumnID, &tk.ParentID); err != nil {
+			return nil, nil, fmt.Errorf("rows scan error: %w", err)
+		}
+		tks = append(tks, tk)
+		ids = append(ids, tk.ID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("encountered during iteration %w", err)
+	}
+
+	insert := `INSERT INTO tasks (position, name, description, colum_id, completed_at)
+	SELECT position, name, description, $2, completed_at FROM tasks WHERE id = $1 RETURNING id`
+	transition := `INSERT INTO task_transitions (task_id, to_column_id, created_at) VALUES ($1, $2, NOW())`
+	tasks := make(map[uuid.UUID]uuid.UUID, len(tks))
+	for _, tk := range tks {
+		var newID uuid.UUID
+		if err = tx.QueryRowContext(ctx, insert, tk.ID, columns[tk.ColumnID]).Scan(&newID); err != nil {
+			return nil, nil, fmt.Errorf("insert task: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, transition, newID, columns[tk.ColumnID]); err != nil {
+			return nil, nil, fmt.Errorf("insert transition: %w", err)
+		}
+		tasks[tk.ID] = newID
+	}
+	for _, tk := range tks {
+		if tk.ParentID == nil {
+			continue
+		}
+		parentID, ok := tasks[*tk.ParentID]
+		if !ok {
+			continue
+		}
+		query = `UPDATE tasks SET parent_id = $2 WHERE id = $1`
+		if _, err = tx.ExecContext(ctx, query, tasks[tk.ID], parentID); err != nil {
+			return nil, nil, fmt.Errorf("update parent: %w", err)
+		}
+	}
+
+	return ids, tasks, nil
+}
+
+// cloneComments copies the live comments of the tasks with ids to their copies.
+func cloneComments(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, tasks map[uuid.UUID]uuid.UUID) error {
+	query := `INSERT INTO comments (text, task_id, created_at)
+	SELECT text, $2, created_at FROM comments WHERE task_id = $1 AND deleted_at IS NULL`
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, query, id, tasks[id]); err != nil {
+			return fmt.Errorf("insert comments: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func selectIDs(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]uuid.UUID, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("rows scan error: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("encountered during iteration %w", err)
+	}
+
+	return ids, nil
+}
+
+// Delete marks the project with its columns, tasks and comments deleted at the same time,
+// so that Restore brings back exactly the items removed with the project.
 func (p *projectRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM projects WHERE id = $1`
-	_, err := p.db.ExecContext(ctx, query, id)
+	queries := []string{
+		`UPDATE projects SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+		`UPDATE columns SET deleted_at = NOW() WHERE project_id = $1 AND deleted_at IS NULL`,
+		`UPDATE tasks SET deleted_at = NOW() WHERE deleted_at IS NULL
+		AND colum_id IN (SELECT id FROM columns WHERE project_id = $1)`,
+		`UPDATE comments SET deleted_at = NOW() WHERE deleted_at IS NULL
+		AND task_id IN (SELECT t.id FROM tasks t JOIN columns c ON t.colum_id = c.id WHERE c.project_id = $1)`,
+	}
+	err := store.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		for _, query := range queries {
+			if _, err := tx.ExecContext(ctx, query, id); err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("delete error: %w", err)
 	}
 
 	return nil
 }
+
+func (p *projectRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	queries := []string{
+		`UPDATE projects SET deleted_at = NULL WHERE id = $1 AND deleted_at = $2`,
+		`UPDATE columns SET deleted_at = NULL WHERE project_id = $1 AND deleted_at = $2`,
+		`UPDATE tasks SET deleted_at = NULL WHERE deleted_at = $2
+		AND colum_id IN (SELECT id FROM columns WHERE project_id = $1)`,
+		`UPDATE comments SET deleted_at = NULL WHERE deleted_at = $2
+		AND task_id IN (SELECT t.id FROM tasks t JOIN columns c ON t.colum_id = c.id WHERE c.project_id = $1)`,
+	}
+	err := store.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		var deletedAt time.Time
+		query := `SELECT deleted_at FROM projects WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`
+		err := tx.QueryRowContext(ctx, query, id).Scan(&deletedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("project: %w", domain.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("select: %w", err)
+		}
+		for _, query := range queries {
+			if _, err = tx.ExecContext(ctx, query, id, deletedAt); err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("restore error: %w", err)
+	}
+
+	return nil
+}
+
+func (p *projectRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM projects WHERE deleted_at < $1`
+	res, err := p.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge error: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return n, nil
+}
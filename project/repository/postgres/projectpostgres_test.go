@@ -2,9 +2,11 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -16,30 +18,53 @@ import (
 func TestFetch(t *testing.T) {
 	is := helper.New(t)
 
+	now := time.Now().UTC()
 	mockProjects := []domain.Project{
-		{Name: "TestName1", Description: "testDescription1"},
-		{Name: "TestName2", Description: "testDescription2"},
+		{
+			Name: "TestName1", Description: "testDescription1", CreatedAt: now, UpdatedAt: now,
+			Labels: []domain.Label{{Name: "bug", Color: "#d73a4a"}},
+		},
+		{Name: "TestName2", Description: "testDescription2", CreatedAt: now, UpdatedAt: now, Labels: []domain.Label{}},
 	}
+	columns := []string{"id", "name", "description", "created_at", "updated_at", "archived_at", "deleted_at", "labels"}
 
-	rows := sqlmock.NewRows([]string{"id", "name", "description"}).
-		AddRow(mockProjects[0].ID, mockProjects[0].Name, mockProjects[0].Description).
-		AddRow(mockProjects[1].ID, mockProjects[1].Name, mockProjects[1].Description)
-
-	query := `SELECT id,name,description FROM projects ORDER BY name`
-
-	t.Run("success", func(t *testing.T) {
-		db, mock, err := sqlmock.New()
-		is.NoErr(err)
-		mock.ExpectQuery(query).WillReturnRows(rows)
-		projects, err := projectRepository.New(db).Fetch(context.TODO())
-		is.NoErr(err)
-		is.Equal(mockProjects, projects)
-	})
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE deleted_at IS NULL AND updated_at >= $1 AND (archived_at IS NULL OR $2) ORDER BY `
+	tt := []struct {
+		name    string
+		filter  domain.Filter
+		orderBy string
+	}{
+		{"default order", domain.Filter{}, "name"},
+		{
+			"sort by updated_at",
+			domain.Filter{SortBy: domain.SortUpdatedAt, Desc: true, ModifiedSince: now},
+			"updated_at DESC",
+		},
+		{"unknown sort key", domain.Filter{SortBy: "name; DROP TABLE projects"}, "name"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := sqlmock.NewRows(columns).
+				AddRow(mockProjects[0].ID, mockProjects[0].Name, mockProjects[0].Description, now, now, nil, nil,
+					[]byte(`[{"name":"bug","color":"#d73a4a"}]`)).
+				AddRow(mockProjects[1].ID, mockProjects[1].Name, mockProjects[1].Description, now, now, nil, nil,
+					[]byte(`[]`))
+			db, mock, err := sqlmock.New()
+			is.NoErr(err)
+			mock.ExpectQuery(regexp.QuoteMeta(query+tc.orderBy)).
+				WithArgs(tc.filter.ModifiedSince, tc.filter.Archived).
+				WillReturnRows(rows)
+			projects, err := projectRepository.New(db).Fetch(context.TODO(), tc.filter)
+			is.NoErr(err)
+			is.Equal(mockProjects, projects)
+		})
+	}
 	t.Run("error", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
-		mock.ExpectQuery(query).WillReturnError(fmt.Errorf("some error"))
-		_, err = projectRepository.New(db).Fetch(context.TODO())
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("some error"))
+		_, err = projectRepository.New(db).Fetch(context.TODO(), domain.Filter{})
 		is.True(err != nil)
 	})
 }
@@ -47,12 +72,15 @@ func TestFetch(t *testing.T) {
 func TestGetByID(t *testing.T) {
 	is := helper.New(t)
 	// nolint:exhaustivestruct
-	mockProject := domain.Project{Name: "TestName1", Description: "testDescription1"}
+	mockProject := domain.Project{Name: "TestName1", Description: "testDescription1", Labels: []domain.Label{}}
 
-	rows := sqlmock.NewRows([]string{"id", "name", "description"}).
-		AddRow(mockProject.ID, mockProject.Name, mockProject.Description)
+	columns := []string{"id", "name", "description", "created_at", "updated_at", "archived_at", "deleted_at", "labels"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(mockProject.ID, mockProject.Name, mockProject.Description, mockProject.CreatedAt, mockProject.UpdatedAt,
+			nil, nil, []byte(`[]`))
 
-	query := `SELECT id,name,description FROM projects WHERE id = $1`
+	query := `SELECT id,name,description,created_at,updated_at,archived_at,deleted_at,labels FROM projects
+	WHERE id = $1 AND deleted_at IS NULL`
 	var id uuid.UUID
 
 	t.Run("success", func(t *testing.T) {
@@ -77,21 +105,33 @@ func TestUpdate(t *testing.T) {
 	// nolint:exhaustivestruct
 	pr := &domain.Project{Name: "TestName1", Description: "testDescription1"}
 
-	query := `UPDATE projects SET name = $2,description = $3 WHERE id = $1`
+	query := `UPDATE projects SET name = $2,description = $3 WHERE id = $1 AND deleted_at IS NULL
+	RETURNING created_at,updated_at`
+	now := time.Now().UTC()
 
 	t.Run("success", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
-		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(pr.ID, pr.Name, pr.Description).
-			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(pr.ID, pr.Name, pr.Description).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(now, now))
 
 		err = projectRepository.New(db).Update(context.TODO(), pr)
 		is.NoErr(err)
+		is.Equal(pr.UpdatedAt, now)
+	})
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		is.NoErr(err)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(pr.ID, pr.Name, pr.Description).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}))
+
+		err = projectRepository.New(db).Update(context.TODO(), pr)
+		is.True(errors.Is(err, domain.ErrNotFound))
 	})
 	t.Run("error", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
-		mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("some error"))
 
 		err = projectRepository.New(db).Update(context.TODO(), pr)
 		is.True(err != nil)
@@ -102,19 +142,21 @@ func TestStore(t *testing.T) {
 	is := helper.New(t)
 	// nolint:exhaustivestruct
 	pr := &domain.Project{Name: "TestName1", Description: "testDescription1"}
-	query := `INSERT INTO projects ( name, description) VALUES ($1, $2) RETURNING id`
+	query := `INSERT INTO projects ( name, description) VALUES ($1, $2) RETURNING id,created_at,updated_at`
 	id := uuid.New()
+	now := time.Now().UTC()
 
 	t.Run("success", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
 		mock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(pr.Name, pr.Description).
-			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(id, now, now))
 
 		err = projectRepository.New(db).Store(context.TODO(), pr)
 		is.NoErr(err)
 		is.Equal(pr.ID, id)
+		is.Equal(pr.CreatedAt, now)
 	})
 	t.Run("error", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
@@ -130,20 +172,187 @@ func TestDelete(t *testing.T) {
 	is := helper.New(t)
 
 	id := uuid.New()
-	query := `DELETE FROM projects WHERE id = $1`
+	queries := []string{
+		`UPDATE projects SET deleted_at = NOW()`,
+		`UPDATE columns SET deleted_at = NOW()`,
+		`UPDATE tasks SET deleted_at = NOW()`,
+		`UPDATE comments SET deleted_at = NOW()`,
+	}
 
 	t.Run("success", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
-		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectBegin()
+		for _, query := range queries {
+			mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
 		err = projectRepository.New(db).Delete(context.TODO(), id)
 		is.NoErr(err)
+		is.NoErr(mock.ExpectationsWereMet())
 	})
 	t.Run("error", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		is.NoErr(err)
-		mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(queries[0])).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(queries[1])).WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectRollback()
 		err = projectRepository.New(db).Delete(context.TODO(), id)
 		is.True(err != nil)
+		is.NoErr(mock.ExpectationsWereMet())
+	})
+}
+
+func TestRestore(t *testing.T) {
+	is := helper.New(t)
+
+	id := uuid.New()
+	deletedAt := time.Now().UTC()
+	selectQuery := `SELECT deleted_at FROM projects WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		is.NoErr(err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+		for _, table := range []string{"projects", "columns", "tasks", "comments"} {
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE `+table+` SET deleted_at = NULL`)).
+				WithArgs(id, deletedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+		err = projectRepository.New(db).Restore(context.TODO(), id)
+		is.NoErr(err)
+		is.NoErr(mock.ExpectationsWereMet())
+	})
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		is.NoErr(err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+		mock.ExpectRollback()
+		err = projectRepository.New(db).Restore(context.TODO(), id)
+		is.True(errors.Is(err, domain.ErrNotFound))
+	})
+}
+
+func TestPurge(t *testing.T) {
+	is := helper.New(t)
+
+	before := time.Now().UTC()
+	db, mock, err := sqlmock.New()
+	is.NoErr(err)
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM projects WHERE deleted_at < $1`)).WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	n, err := projectRepository.New(db).Purge(context.TODO(), before)
+	is.NoErr(err)
+	is.Equal(n, int64(2))
+}
+
+func TestClone(t *testing.T) {
+	is := helper.New(t)
+
+	id := uuid.New()
+	now := time.Now().UTC()
+	// nolint:exhaustivestruct
+	pr := &domain.Project{Name: "copy", Description: "description", Labels: []domain.Label{{Name: "bug"}}}
+	newID, columnID, newColumnID := uuid.New(), uuid.New(), uuid.New()
+	parentID, childID, newParentID, newChildID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	db, mock, err := sqlmock.New()
+	is.NoErr(err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO projects`)).
+		WithArgs(pr.Name, pr.Description, []byte(`[{"name":"bug"}]`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(newID, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM columns`)).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(columnID))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO columns`)).WithArgs(columnID, newID, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(newColumnID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT t.id,t.colum_id,t.parent_id FROM tasks`)).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "colum_id", "parent_id"}).
+			AddRow(parentID, columnID, nil).AddRow(childID, columnID, parentID))
+	for _, ids := range [][2]uuid.UUID{{parentID, newParentID}, {childID, newChildID}} {
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO tasks`)).WithArgs(ids[0], newColumnID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ids[1]))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO task_transitions`)).WithArgs(ids[1], newColumnID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE tasks SET parent_id = $2`)).WithArgs(newChildID, newParentID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, ids := range [][2]uuid.UUID{{parentID, newParentID}, {childID, newChildID}} {
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO comments`)).WithArgs(ids[0], ids[1]).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	// nolint:exhaustivestruct
+	err = projectRepository.New(db).Clone(context.TODO(), id, pr, domain.CloneOptions{Tasks: true, Comments: true})
+	is.NoErr(err)
+	is.Equal(pr.ID, newID)
+	is.NoErr(mock.ExpectationsWereMet())
+
+	t.Run("rollback", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		is.NoErr(err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO projects`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(newID, now, now))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM columns`)).WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectRollback()
+		// nolint:exhaustivestruct
+		err = projectRepository.New(db).Clone(context.TODO(), id, pr, domain.CloneOptions{})
+		is.True(err != nil)
+		is.NoErr(mock.ExpectationsWereMet())
 	})
 }
+
+func TestImport(t *testing.T) {
+	is := helper.New(t)
+
+	now := time.Now().UTC()
+	commentedAt := now.Add(-time.Hour)
+	// nolint:exhaustivestruct
+	pr := &domain.Project{Name: "board", Labels: []domain.Label{}}
+	parentID, childID := uuid.New(), uuid.New()
+	newID, columnID, newParentID, newChildID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	// nolint:exhaustivestruct
+	ex := &domain.Export{Columns: []domain.ExportedColumn{{
+		Name:     "todo",
+		Status:   "todo",
+		Category: domain.CategoryTodo,
+		Tasks: []domain.ExportedTask{
+			{ID: parentID, Name: "parent", Comments: []domain.ExportedComment{{Text: "note", CreatedAt: commentedAt}}},
+			{ID: childID, Name: "child", ParentID: &parentID},
+		},
+	}}}
+
+	db, mock, err := sqlmock.New()
+	is.NoErr(err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO projects`)).WithArgs(pr.Name, pr.Description, []byte(`[]`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(newID, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO columns`)).
+		WithArgs(0, "todo", "todo", domain.CategoryTodo, nil, newID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(columnID))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO tasks`)).WithArgs(0, "parent", "", columnID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(newParentID))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO task_transitions`)).WithArgs(newParentID, columnID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO comments`)).WithArgs("note", newParentID, commentedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO tasks`)).WithArgs(1, "child", "", columnID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(newChildID))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO task_transitions`)).WithArgs(newChildID, columnID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE tasks SET parent_id = $2`)).WithArgs(newChildID, newParentID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = projectRepository.New(db).Import(context.TODO(), pr, ex)
+	is.NoErr(err)
+	is.Equal(pr.ID, newID)
+	is.NoErr(mock.ExpectationsWereMet())
+}
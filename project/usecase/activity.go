@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// activityLimit is the number of the most recent activities of a project.
+const activityLimit = 50
+
+func (p *projectUsecase) FetchActivity(ctx context.Context, id uuid.UUID) (domain.ProjectActivity, error) {
+	b, err := p.fetchBoard(ctx, id)
+	if err != nil {
+		return domain.ProjectActivity{}, err
+	}
+	transitions, err := p.transRepo.FetchRecentByProjectID(ctx, id, activityLimit)
+	if err != nil {
+		return domain.ProjectActivity{}, fmt.Errorf("fetch recent transitions by project id: %w", err)
+	}
+	comments, err := p.commentRepo.FetchRecentByProjectID(ctx, id, activityLimit)
+	if err != nil {
+		return domain.ProjectActivity{}, fmt.Errorf("fetch recent comments by project id: %w", err)
+	}
+
+	return domain.ProjectActivity{Project: b.project, Activities: activities(b, transitions, comments)}, nil
+}
+
+// activities returns the creations of the tasks, their moves and the comments, the newest first.
+// A task is created in the column of the transition that has no column to move from.
+func activities(b board, transitions []domain.Transition, comments []domain.Comment) []domain.Activity {
+	columnByID := make(map[uuid.UUID]domain.Column, len(b.columns))
+	taskByID := make(map[uuid.UUID]domain.Task)
+	for _, c := range b.columns {
+		columnByID[c.ID] = c
+		for _, tk := range b.tasks[c.ID] {
+			taskByID[tk.ID] = tk
+		}
+	}
+	result := make([]domain.Activity, 0, len(transitions)+len(comments))
+	for _, tr := range transitions {
+		tk, ok := taskByID[tr.TaskID]
+		if !ok {
+			continue
+		}
+		// nolint:exhaustivestruct
+		activity := domain.Activity{
+			ID:        tr.ID,
+			Kind:      domain.ActivityTaskMoved,
+			Task:      tk,
+			Column:    columnByID[tr.ToColumnID],
+			CreatedAt: tr.CreatedAt,
+			UpdatedAt: tr.CreatedAt,
+		}
+		if tr.FromColumnID == nil {
+			activity.ID = tk.ID
+			activity.Kind = domain.ActivityTaskCreated
+		} else {
+			from := columnByID[*tr.FromColumnID]
+			activity.FromColumn = &from
+		}
+		result = append(result, activity)
+	}
+	for n := range comments {
+		cm := comments[n]
+		tk, ok := taskByID[cm.TaskID]
+		if !ok {
+			continue
+		}
+		// nolint:exhaustivestruct
+		result = append(result, domain.Activity{
+			ID:        cm.ID,
+			Kind:      domain.ActivityCommentAdded,
+			Task:      tk,
+			Column:    columnByID[tk.ColumnID],
+			Comment:   &cm,
+			CreatedAt: cm.CreatedAt,
+			UpdatedAt: cm.UpdatedAt,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if len(result) > activityLimit {
+		result = result[:activityLimit]
+	}
+
+	return result
+}
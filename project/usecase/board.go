@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// board represent a project with its live columns in board order, their tasks and the comments of the tasks.
+type board struct {
+	project domain.Project
+	columns []domain.Column
+	// tasks are the tasks of the columns by column id, in board order.
+	tasks map[uuid.UUID][]domain.Task
+	// comments are the comments of the tasks by task id, the oldest first, when they are loaded.
+	comments map[uuid.UUID][]domain.Comment
+}
+
+// fetchBoard loads the project with id together with its columns and tasks, without the comments.
+func (p *projectUsecase) fetchBoard(ctx context.Context, id uuid.UUID) (board, error) {
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return board{}, fmt.Errorf("get project by id: %w", err)
+	}
+	columns, err := p.columnRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return board{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	tasks, err := p.taskRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return board{}, fmt.Errorf("fetch tasks by project id: %w", err)
+	}
+
+	return newBoard(project, columns, tasks), nil
+}
+
+// fetchBoardWithComments loads the project with id together with its whole board.
+func (p *projectUsecase) fetchBoardWithComments(ctx context.Context, id uuid.UUID) (board, error) {
+	b, err := p.fetchBoard(ctx, id)
+	if err != nil {
+		return board{}, err
+	}
+	comments, err := p.commentRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return board{}, fmt.Errorf("fetch comments by project id: %w", err)
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+	for _, cm := range comments {
+		b.comments[cm.TaskID] = append(b.comments[cm.TaskID], cm)
+	}
+
+	return b, nil
+}
+
+func newBoard(project domain.Project, columns []domain.Column, tasks []domain.Task) board {
+	b := board{
+		project:  project,
+		columns:  columns,
+		tasks:    make(map[uuid.UUID][]domain.Task, len(columns)),
+		comments: make(map[uuid.UUID][]domain.Comment),
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].Position < tasks[j].Position })
+	for _, tk := range tasks {
+		b.tasks[tk.ColumnID] = append(b.tasks[tk.ColumnID], tk)
+	}
+
+	return b
+}
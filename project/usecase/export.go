@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+func (p *projectUsecase) Export(ctx context.Context, id uuid.UUID) (domain.Export, error) {
+	b, err := p.fetchBoardWithComments(ctx, id)
+	if err != nil {
+		return domain.Export{}, err
+	}
+
+	return export(b, time.Now().UTC()), nil
+}
+
+// export builds the export document of the project with the columns and tasks in board order.
+// A task whose parent is not exported, e.g. because the parent is in the trash, is exported
+// without its parent so that the document can be imported.
+func export(b board, now time.Time) domain.Export {
+	exported := make(map[uuid.UUID]bool)
+	for _, c := range b.columns {
+		for _, tk := range b.tasks[c.ID] {
+			exported[tk.ID] = true
+		}
+	}
+	ex := domain.Export{
+		Version:    domain.ExportVersion,
+		ExportedAt: now,
+		Project: domain.ExportedProject{
+			Name: b.project.Name, Description: b.project.Description, Labels: b.project.Labels,
+		},
+		Columns: make([]domain.ExportedColumn, 0, len(b.columns)),
+	}
+	for _, c := range b.columns {
+		columnTasks := make([]domain.ExportedTask, 0, len(b.tasks[c.ID]))
+		for _, tk := range b.tasks[c.ID] {
+			taskComments := make([]domain.ExportedComment, 0, len(b.comments[tk.ID]))
+			for _, cm := range b.comments[tk.ID] {
+				taskComments = append(taskComments, domain.ExportedComment{Text: cm.Text, CreatedAt: cm.CreatedAt})
+			}
+			parentID := tk.ParentID
+			if parentID != nil && !exported[*parentID] {
+				parentID = nil
+			}
+			columnTasks = append(columnTasks, domain.ExportedTask{
+				ID:          tk.ID,
+				Name:        tk.Name,
+				Description: tk.Description,
+				ParentID:    parentID,
+				CompletedAt: tk.CompletedAt,
+				Comments:    taskComments,
+			})
+		}
+		ex.Columns = append(ex.Columns, domain.ExportedColumn{
+			Name:     c.Name,
+			Status:   c.Status,
+			Category: c.Category,
+			WIPLimit: c.WIPLimit,
+			Tasks:    columnTasks,
+		})
+	}
+
+	return ex
+}
+
+// Import recreates the project of the export document with new ids.
+func (p *projectUsecase) Import(ctx context.Context, ex *domain.Export) (domain.Project, error) {
+	if err := checkExport(ex); err != nil {
+		return domain.Project{}, err
+	}
+	// nolint:exhaustivestruct
+	project := domain.Project{Name: ex.Project.Name, Description: ex.Project.Description, Labels: ex.Project.Labels}
+	if project.Labels == nil {
+		project.Labels = []domain.Label{}
+	}
+	if err := p.projectRepo.Import(ctx, &project, ex); err != nil {
+		return domain.Project{}, fmt.Errorf("import project: %w", err)
+	}
+
+	return project, nil
+}
+
+// checkExport verifies that the board of the export document could be created
+// and fills in the default column category.
+func checkExport(ex *domain.Export) error {
+	if ex.Version != domain.ExportVersion {
+		return fmt.Errorf("export version %d: %w", ex.Version, domain.ErrBadParamInput)
+	}
+	if err := domain.CheckLabels(ex.Project.Labels); err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(ex.Columns))
+	statuses := make(map[string]bool, len(ex.Columns))
+	parents := make(map[uuid.UUID]*uuid.UUID)
+	for i := range ex.Columns {
+		c := &ex.Columns[i]
+		if names[c.Name] {
+			return fmt.Errorf("column name: %w", domain.ErrUnique)
+		}
+		if statuses[c.Status] {
+			return fmt.Errorf("column status: %w", domain.ErrUnique)
+		}
+		names[c.Name], statuses[c.Status] = true, true
+		if c.Category == "" {
+			c.Category = domain.CategoryTodo
+		}
+		for _, tk := range c.Tasks {
+			if _, ok := parents[tk.ID]; ok {
+				return fmt.Errorf("task id %s: %w", tk.ID, domain.ErrUnique)
+			}
+			parents[tk.ID] = tk.ParentID
+		}
+	}
+	for id, parentID := range parents {
+		for steps := 0; parentID != nil; steps++ {
+			next, ok := parents[*parentID]
+			if !ok {
+				return fmt.Errorf("task %s parent %s: %w", id, parentID, domain.ErrBadParamInput)
+			}
+			if steps == len(parents) {
+				return fmt.Errorf("task %s: %w", id, domain.ErrParentCycle)
+			}
+			parentID = next
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+func (p *projectUsecase) FetchFlowMetrics(
+	ctx context.Context,
+	id uuid.UUID,
+	from, to time.Time,
+) (domain.FlowMetrics, error) {
+	if _, err := p.projectRepo.GetByID(ctx, id); err != nil {
+		return domain.FlowMetrics{}, fmt.Errorf("get project by id: %w", err)
+	}
+	columns, err := p.columnRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.FlowMetrics{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	tasks, err := p.taskRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.FlowMetrics{}, fmt.Errorf("fetch tasks by project id: %w", err)
+	}
+	transitions, err := p.transRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.FlowMetrics{}, fmt.Errorf("fetch transitions by project id: %w", err)
+	}
+
+	return flowMetrics(columns, tasks, transitions, from, to), nil
+}
+
+// maxCFDDays is the longest date range of a cumulative flow diagram.
+const maxCFDDays = 366
+
+func (p *projectUsecase) FetchCFD(ctx context.Context, id uuid.UUID, from, to time.Time) (domain.CFD, error) {
+	if to.Sub(from) > maxCFDDays*24*time.Hour {
+		return domain.CFD{}, fmt.Errorf("cfd range over %d days: %w", maxCFDDays, domain.ErrBadParamInput)
+	}
+	if _, err := p.projectRepo.GetByID(ctx, id); err != nil {
+		return domain.CFD{}, fmt.Errorf("get project by id: %w", err)
+	}
+	columns, err := p.columnRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.CFD{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	tasks, err := p.taskRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.CFD{}, fmt.Errorf("fetch tasks by project id: %w", err)
+	}
+	transitions, err := p.transRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.CFD{}, fmt.Errorf("fetch transitions by project id: %w", err)
+	}
+
+	return cfd(columns, tasks, transitions, from, to), nil
+}
+
+// cfd counts the tasks of each column at the end of every day within [from, to).
+// Tasks without recorded transitions are counted in their current column since their creation.
+func cfd(columns []domain.Column, tasks []domain.Task, transitions []domain.Transition, from, to time.Time) domain.CFD {
+	byTask := make(map[uuid.UUID][]domain.Transition)
+	for _, tr := range transitions {
+		byTask[tr.TaskID] = append(byTask[tr.TaskID], tr)
+	}
+	index := make(map[uuid.UUID]int, len(columns))
+	result := domain.CFD{Dates: make([]string, 0), Columns: make([]domain.CFDSeries, len(columns))}
+	for i, c := range columns {
+		index[c.ID] = i
+		result.Columns[i] = domain.CFDSeries{ColumnID: c.ID, Name: c.Name, Status: c.Status, Counts: make([]int, 0)}
+	}
+	var ends []time.Time
+	for day := from.UTC().Truncate(24 * time.Hour); day.Before(to); day = day.AddDate(0, 0, 1) {
+		result.Dates = append(result.Dates, day.Format("2006-01-02"))
+		ends = append(ends, day.AddDate(0, 0, 1))
+		for i := range result.Columns {
+			result.Columns[i].Counts = append(result.Columns[i].Counts, 0)
+		}
+	}
+	for _, tk := range tasks {
+		history := byTask[tk.ID]
+		if len(history) == 0 {
+			// nolint:exhaustivestruct
+			history = []domain.Transition{{TaskID: tk.ID, ToColumnID: tk.ColumnID, CreatedAt: tk.CreatedAt}}
+		}
+		next := 0
+		for d, end := range ends {
+			for next < len(history) && history[next].CreatedAt.Before(end) {
+				next++
+			}
+			if next == 0 {
+				continue
+			}
+			if i, ok := index[history[next-1].ToColumnID]; ok {
+				result.Columns[i].Counts[d]++
+			}
+		}
+	}
+
+	return result
+}
+
+// flowMetrics computes the timing of the tasks completed within [from, to).
+// Work on a task starts when it first enters a column that is not a todo one
+// and the time spent in a column runs until the task enters the next one.
+// Tasks moved before transitions were recorded have no cycle time.
+func flowMetrics(
+	columns []domain.Column,
+	tasks []domain.Task,
+	transitions []domain.Transition,
+	from, to time.Time,
+) domain.FlowMetrics {
+	byTask := make(map[uuid.UUID][]domain.Transition)
+	for _, tr := range transitions {
+		byTask[tr.TaskID] = append(byTask[tr.TaskID], tr)
+	}
+	categories := make(map[uuid.UUID]domain.Category, len(columns))
+	for _, c := range columns {
+		categories[c.ID] = c.Category
+	}
+	metrics := domain.FlowMetrics{From: from, To: to, Tasks: make([]domain.TaskFlow, 0)}
+	var leadTimes, cycleTimes []float64
+	columnTimes := make(map[uuid.UUID][]float64)
+	for _, tk := range tasks {
+		if tk.CompletedAt == nil || tk.CompletedAt.Before(from) || !tk.CompletedAt.Before(to) {
+			continue
+		}
+		completed := *tk.CompletedAt
+		started := completed
+		spent := make(map[uuid.UUID]time.Duration)
+		history := byTask[tk.ID]
+		for i, tr := range history {
+			if categories[tr.ToColumnID] != domain.CategoryTodo && tr.CreatedAt.Before(started) {
+				started = tr.CreatedAt
+			}
+			if i+1 < len(history) {
+				spent[tr.ToColumnID] += history[i+1].CreatedAt.Sub(tr.CreatedAt)
+			}
+		}
+		for columnID, d := range spent {
+			columnTimes[columnID] = append(columnTimes[columnID], hours(d))
+		}
+		flow := domain.TaskFlow{
+			TaskID:      tk.ID,
+			Name:        tk.Name,
+			CompletedAt: completed,
+			LeadTime:    hours(completed.Sub(tk.CreatedAt)),
+			CycleTime:   nil,
+		}
+		leadTimes = append(leadTimes, flow.LeadTime)
+		if len(history) > 0 {
+			cycleTime := hours(completed.Sub(started))
+			flow.CycleTime = &cycleTime
+			cycleTimes = append(cycleTimes, cycleTime)
+		}
+		metrics.Tasks = append(metrics.Tasks, flow)
+	}
+	sort.Slice(metrics.Tasks, func(i, j int) bool {
+		return metrics.Tasks[i].CompletedAt.Before(metrics.Tasks[j].CompletedAt)
+	})
+	metrics.Completed = len(metrics.Tasks)
+	metrics.LeadTime = percentiles(leadTimes)
+	metrics.CycleTime = percentiles(cycleTimes)
+	metrics.Columns = make([]domain.ColumnFlow, 0, len(columns))
+	for _, c := range columns {
+		metrics.Columns = append(metrics.Columns, domain.ColumnFlow{
+			ColumnID: c.ID,
+			Name:     c.Name,
+			Status:   c.Status,
+			Tasks:    len(columnTimes[c.ID]),
+			Time:     percentiles(columnTimes[c.ID]),
+		})
+	}
+
+	return metrics
+}
+
+// percentiles returns the nearest-rank percentiles of values.
+func percentiles(values []float64) domain.Percentiles {
+	if len(values) == 0 {
+		return domain.Percentiles{P50: 0, P85: 0, P95: 0}
+	}
+	sort.Float64s(values)
+	rank := func(p float64) float64 {
+		return values[int(math.Ceil(p*float64(len(values))))-1]
+	}
+
+	return domain.Percentiles{P50: rank(0.50), P85: rank(0.85), P95: rank(0.95)}
+}
+
+// hours returns d in hours rounded to hundredths.
+func hours(d time.Duration) float64 {
+	const precision = 100
+
+	return math.Round(d.Hours()*precision) / precision
+}
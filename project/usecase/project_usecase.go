@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
@@ -12,15 +14,35 @@ type projectUsecase struct {
 	projectRepo domain.ProjectRepository
 	columnRepo  domain.ColumnRepository
 	taskRepo    domain.TaskRepository
+	transRepo   domain.TransitionRepository
+	tmplRepo    domain.TemplateRepository
+	commentRepo domain.CommentRepository
+	taskUsecase domain.TaskUsecase
 }
 
 // New will create new a projectUsecase object representation of domain.ProjectUsecase interface.
-func New(p domain.ProjectRepository, c domain.ColumnRepository, t domain.TaskRepository) domain.ProjectUsecase {
-	return &projectUsecase{projectRepo: p, columnRepo: c, taskRepo: t}
+func New(
+	p domain.ProjectRepository,
+	c domain.ColumnRepository,
+	t domain.TaskRepository,
+	tr domain.TransitionRepository,
+	tm domain.TemplateRepository,
+	cm domain.CommentRepository,
+	tu domain.TaskUsecase,
+) domain.ProjectUsecase {
+	return &projectUsecase{
+		projectRepo: p,
+		columnRepo:  c,
+		taskRepo:    t,
+		transRepo:   tr,
+		tmplRepo:    tm,
+		commentRepo: cm,
+		taskUsecase: tu,
+	}
 }
 
-func (p *projectUsecase) Fetch(ctx context.Context) ([]domain.Project, error) {
-	return p.projectRepo.Fetch(ctx)
+func (p *projectUsecase) Fetch(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
+	return p.projectRepo.Fetch(ctx, f)
 }
 
 func (p *projectUsecase) FetchColumns(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
@@ -32,10 +54,13 @@ func (p *projectUsecase) FetchColumns(ctx context.Context, id uuid.UUID) ([]doma
 }
 
 func (p *projectUsecase) StoreColumn(ctx context.Context, cm *domain.Column) error {
-	_, err := p.projectRepo.GetByID(ctx, cm.ProjectID)
+	project, err := p.projectRepo.GetByID(ctx, cm.ProjectID)
 	if err != nil {
 		return fmt.Errorf("get project by id: %w", err)
 	}
+	if project.IsArchived() {
+		return domain.ErrArchived
+	}
 	columns, err := p.columnRepo.FetchByProjectID(ctx, cm.ProjectID)
 	if err != nil {
 		return fmt.Errorf("fetch by project id: %w", err)
@@ -44,6 +69,9 @@ func (p *projectUsecase) StoreColumn(ctx context.Context, cm *domain.Column) err
 	if ok, err = isUnique(columns, cm); !ok {
 		return err
 	}
+	if cm.Category == "" {
+		cm.Category = domain.CategoryTodo
+	}
 	if cm.Position >= len(columns) {
 		cm.Position = len(columns)
 
@@ -74,14 +102,19 @@ func (p *projectUsecase) GetByID(ctx context.Context, id uuid.UUID) (domain.Proj
 }
 
 func (p *projectUsecase) Update(ctx context.Context, pr *domain.Project) error {
-	if _, err := p.projectRepo.GetByID(ctx, pr.ID); err != nil {
+	old, err := p.projectRepo.GetByID(ctx, pr.ID)
+	if err != nil {
 		return fmt.Errorf("update project : %w", err)
 	}
+	if old.IsArchived() {
+		return domain.ErrArchived
+	}
 
 	return p.projectRepo.Update(ctx, pr)
 }
 
 func (p *projectUsecase) Store(ctx context.Context, m *domain.Project) error {
+	m.Labels = []domain.Label{}
 	err := p.projectRepo.Store(ctx, m)
 	if err != nil {
 		return fmt.Errorf("store project: %w", err)
@@ -90,6 +123,7 @@ func (p *projectUsecase) Store(ctx context.Context, m *domain.Project) error {
 	err = p.columnRepo.Store(ctx, &domain.Column{
 		Name:      "Default",
 		Status:    "Default",
+		Category:  domain.CategoryTodo,
 		Position:  0,
 		ProjectID: m.ID,
 	})
@@ -100,6 +134,63 @@ func (p *projectUsecase) Store(ctx context.Context, m *domain.Project) error {
 	return nil
 }
 
+// StoreFromTemplate stores the project with the columns and labels of the template instead of the default column.
+func (p *projectUsecase) StoreFromTemplate(ctx context.Context, pr *domain.Project, templateID uuid.UUID) error {
+	tm, err := p.tmplRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return fmt.Errorf("get template by id: %w", err)
+	}
+	columns := make([]domain.Column, len(tm.Columns))
+	for i, c := range tm.Columns {
+		// nolint:exhaustivestruct
+		columns[i] = domain.Column{
+			Position: i,
+			Name:     c.Name,
+			Status:   c.Status,
+			Category: c.Category,
+			WIPLimit: c.WIPLimit,
+		}
+		if columns[i].Category == "" {
+			columns[i].Category = domain.CategoryTodo
+		}
+	}
+	pr.Labels = tm.Labels
+	if err = p.projectRepo.StoreWithColumns(ctx, pr, columns); err != nil {
+		return fmt.Errorf("store project: %w", err)
+	}
+
+	return nil
+}
+
+// Clone copies the project into a new one. The name of the copy defaults to the name
+// of the project with a "(copy)" suffix and the description to the one of the project.
+// The copy keeps the labels of the project.
+func (p *projectUsecase) Clone(ctx context.Context, id uuid.UUID, opts domain.CloneOptions) (domain.Project, error) {
+	if opts.Comments && !opts.Tasks {
+		return domain.Project{}, fmt.Errorf("comments are cloned with tasks only: %w", domain.ErrBadParamInput)
+	}
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("get project by id: %w", err)
+	}
+	// nolint:exhaustivestruct
+	clone := domain.Project{Name: opts.Name, Description: opts.Description, Labels: project.Labels}
+	if clone.Name == "" {
+		clone.Name = project.Name + " (copy)"
+		if utf8.RuneCountInString(clone.Name) > domain.MaxProjectName {
+			clone.Name = project.Name
+		}
+	}
+	if clone.Description == "" {
+		clone.Description = project.Description
+	}
+	if err = p.projectRepo.Clone(ctx, id, &clone, opts); err != nil {
+		return domain.Project{}, fmt.Errorf("clone project: %w", err)
+	}
+
+	return clone, nil
+}
+
 func (p *projectUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 	if _, err := p.projectRepo.GetByID(ctx, id); err != nil {
 		return fmt.Errorf("delete project : %w", err)
@@ -108,6 +199,71 @@ func (p *projectUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 	return p.projectRepo.Delete(ctx, id)
 }
 
+func (p *projectUsecase) FetchDeleted(ctx context.Context) ([]domain.Project, error) {
+	return p.projectRepo.FetchDeleted(ctx)
+}
+
+func (p *projectUsecase) FetchTrash(ctx context.Context, id uuid.UUID) (domain.Trash, error) {
+	if _, err := p.projectRepo.GetByID(ctx, id); err != nil {
+		return domain.Trash{}, fmt.Errorf("get project by id: %w", err)
+	}
+	columns, err := p.columnRepo.FetchDeletedByProjectID(ctx, id)
+	if err != nil {
+		return domain.Trash{}, fmt.Errorf("fetch deleted columns by project id: %w", err)
+	}
+	tasks, err := p.taskRepo.FetchDeletedByProjectID(ctx, id)
+	if err != nil {
+		return domain.Trash{}, fmt.Errorf("fetch deleted tasks by project id: %w", err)
+	}
+
+	return domain.Trash{Columns: columns, Tasks: tasks}, nil
+}
+
+func (p *projectUsecase) Restore(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	if _, err := p.projectRepo.GetDeletedByID(ctx, id); err != nil {
+		return domain.Project{}, fmt.Errorf("get deleted project by id: %w", err)
+	}
+	if err := p.projectRepo.Restore(ctx, id); err != nil {
+		return domain.Project{}, fmt.Errorf("restore project: %w", err)
+	}
+
+	return p.projectRepo.GetByID(ctx, id)
+}
+
+// Archive makes the board of the project read-only and hides the project from the default listing.
+func (p *projectUsecase) Archive(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("get project by id: %w", err)
+	}
+	if project.IsArchived() {
+		return project, nil
+	}
+	now := time.Now().UTC()
+	project.ArchivedAt = &now
+	if err = p.projectRepo.SetArchivedAt(ctx, &project); err != nil {
+		return domain.Project{}, fmt.Errorf("archive project: %w", err)
+	}
+
+	return project, nil
+}
+
+func (p *projectUsecase) Unarchive(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Project{}, fmt.Errorf("get project by id: %w", err)
+	}
+	if !project.IsArchived() {
+		return project, nil
+	}
+	project.ArchivedAt = nil
+	if err = p.projectRepo.SetArchivedAt(ctx, &project); err != nil {
+		return domain.Project{}, fmt.Errorf("unarchive project: %w", err)
+	}
+
+	return project, nil
+}
+
 func isUnique(columns []domain.Column, column *domain.Column) (bool, error) {
 	for _, c := range columns {
 		if column.ID == c.ID {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
@@ -19,12 +21,14 @@ func TestFetch(t *testing.T) {
 	want := []domain.Project{{Name: "1", Description: "testDescription"}}
 	// nolint:exhaustivestruct
 	mockedProjectRepo := &mocks.ProjectRepositoryMock{
-		FetchFunc: func(ctx context.Context) ([]domain.Project, error) {
+		FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Project, error) {
 			return want, nil
 		},
 	}
-	u := projectUsecase.New(mockedProjectRepo, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
-	projects, err := u.Fetch(context.TODO())
+	u := projectUsecase.New(mockedProjectRepo, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
+	projects, err := u.Fetch(context.TODO(), domain.Filter{})
 	is.NoErr(err)
 	is.Equal(want, projects)
 	is.Equal(len(mockedProjectRepo.FetchCalls()), 1)
@@ -47,7 +51,9 @@ func TestFetchColumns(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	projects, err := u.FetchColumns(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, projects)
@@ -75,7 +81,9 @@ func TestFetchColumnsError(t *testing.T) {
 			return nil, nil
 		},
 	}
-	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	_, err := u.FetchColumns(context.TODO(), id)
 	is.True(err != nil)
 	cp := mp.GetByIDCalls()
@@ -118,7 +126,9 @@ func TestStoreColumn(t *testing.T) {
 					return nil
 				},
 			}
-			u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+			u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+				&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+				&mocks.TaskUsecaseMock{})
 			err := u.StoreColumn(context.TODO(), &tc.cl)
 			is.NoErr(err)
 			cg := mp.GetByIDCalls()
@@ -203,7 +213,9 @@ func TestStoreColumnErrors(t *testing.T) {
 					return nil
 				},
 			}
-			u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+			u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+				&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+				&mocks.TaskUsecaseMock{})
 			err := u.StoreColumn(context.TODO(), &tc.cl)
 			is.True(err != nil)
 		})
@@ -227,7 +239,9 @@ func TestFetchTask(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, mt)
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, mt,
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	projects, err := u.FetchTasks(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, projects)
@@ -255,7 +269,9 @@ func TestFetchTaskError(t *testing.T) {
 			return nil, nil
 		},
 	}
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, mt)
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, mt,
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	_, err := u.FetchTasks(context.TODO(), id)
 	is.True(err != nil)
 
@@ -278,7 +294,9 @@ func TestGetByID(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	project, err := u.GetByID(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, project)
@@ -300,7 +318,9 @@ func TestUpdate(t *testing.T) {
 			return nil
 		},
 	}
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	err := u.Update(context.TODO(), &project)
 	is.NoErr(err)
 	cg := mp.GetByIDCalls()
@@ -323,7 +343,9 @@ func TestUpdateError(t *testing.T) {
 			return nil
 		},
 	}
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	err := u.Update(context.TODO(), &project)
 	is.True(err != nil)
 	cg := mp.GetByIDCalls()
@@ -352,7 +374,9 @@ func TestStore(t *testing.T) {
 		},
 	}
 
-	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	err := u.Store(context.TODO(), &project)
 	is.NoErr(err)
 
@@ -383,7 +407,9 @@ func TestStoreErrors(t *testing.T) {
 				return nil
 			},
 		}
-		u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+		u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+			&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+			&mocks.TaskUsecaseMock{})
 		err := u.Store(context.TODO(), &project)
 		is.True(err != nil)
 		cp := mp.StoreCalls()
@@ -405,7 +431,9 @@ func TestStoreErrors(t *testing.T) {
 				return errors.New("some error")
 			},
 		}
-		u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{})
+		u := projectUsecase.New(mp, mc, &mocks.TaskRepositoryMock{},
+			&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+			&mocks.TaskUsecaseMock{})
 		err := u.Store(context.TODO(), &project)
 		is.True(err != nil)
 		cp := mp.StoreCalls()
@@ -431,7 +459,9 @@ func TestDelete(t *testing.T) {
 		},
 	}
 
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	err := u.Delete(context.TODO(), id)
 	is.NoErr(err)
 
@@ -457,7 +487,9 @@ func TestDeleteError(t *testing.T) {
 		},
 	}
 
-	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{})
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
 	err := u.Delete(context.TODO(), id)
 	is.True(err != nil)
 
@@ -467,3 +499,637 @@ func TestDeleteError(t *testing.T) {
 	cd := mp.DeleteCalls()
 	is.Equal(len(cd), 0)
 }
+
+//nolint:exhaustivestruct,funlen
+func TestFetchFlowMetrics(t *testing.T) {
+	is := helper.New(t)
+
+	todoID := uuid.New()
+	doingID := uuid.New()
+	doneID := uuid.New()
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return start.Add(time.Duration(h) * time.Hour) }
+	completed := func(h int) *time.Time {
+		t := at(h)
+
+		return &t
+	}
+	tasks := []domain.Task{
+		{ID: uuid.New(), Name: "0", ColumnID: doneID, CreatedAt: at(0), CompletedAt: completed(10)},
+		{ID: uuid.New(), Name: "1", ColumnID: doneID, CreatedAt: at(0), CompletedAt: completed(20)},
+		{ID: uuid.New(), Name: "old", ColumnID: doneID, CreatedAt: at(0), CompletedAt: completed(1000)},
+		{ID: uuid.New(), Name: "open", ColumnID: doingID, CreatedAt: at(0)},
+	}
+	transitions := []domain.Transition{
+		{TaskID: tasks[0].ID, ToColumnID: todoID, CreatedAt: at(0)},
+		{TaskID: tasks[1].ID, ToColumnID: todoID, CreatedAt: at(0)},
+		{TaskID: tasks[0].ID, FromColumnID: &todoID, ToColumnID: doingID, CreatedAt: at(4)},
+		{TaskID: tasks[1].ID, FromColumnID: &todoID, ToColumnID: doingID, CreatedAt: at(8)},
+		{TaskID: tasks[0].ID, FromColumnID: &doingID, ToColumnID: doneID, CreatedAt: at(10)},
+		{TaskID: tasks[1].ID, FromColumnID: &doingID, ToColumnID: doneID, CreatedAt: at(20)},
+	}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{
+				{ID: todoID, Name: "todo", Category: domain.CategoryTodo},
+				{ID: doingID, Name: "doing", Category: domain.CategoryInProgress},
+				{ID: doneID, Name: "done", Category: domain.CategoryDone},
+			}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return tasks, nil
+		},
+	}
+	mtr := &mocks.TransitionRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Transition, error) {
+			return transitions, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, mtr, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
+	got, err := u.FetchFlowMetrics(context.TODO(), uuid.New(), start, at(100))
+	is.NoErr(err)
+	is.Equal(got.Completed, 2)
+	is.Equal(got.LeadTime, domain.Percentiles{P50: 10, P85: 20, P95: 20})
+	is.Equal(got.CycleTime, domain.Percentiles{P50: 6, P85: 12, P95: 12})
+	is.Equal(*got.Tasks[1].CycleTime, 12.0)
+	is.Equal(len(got.Columns), 3)
+	is.Equal(got.Columns[0].Tasks, 2)
+	is.Equal(got.Columns[0].Time, domain.Percentiles{P50: 4, P85: 8, P95: 8})
+	is.Equal(got.Columns[1].Time, domain.Percentiles{P50: 6, P85: 12, P95: 12})
+	is.Equal(got.Columns[2].Tasks, 0)
+}
+
+//nolint:exhaustivestruct
+func TestFetchCFD(t *testing.T) {
+	is := helper.New(t)
+
+	todoID := uuid.New()
+	doneID := uuid.New()
+	day := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.UTC) }
+	tasks := []domain.Task{
+		{ID: uuid.New(), ColumnID: doneID, CreatedAt: day(1, 10)},
+		{ID: uuid.New(), ColumnID: todoID, CreatedAt: day(2, 10)},
+	}
+	transitions := []domain.Transition{
+		{TaskID: tasks[0].ID, ToColumnID: todoID, CreatedAt: day(1, 10)},
+		{TaskID: tasks[0].ID, FromColumnID: &todoID, ToColumnID: doneID, CreatedAt: day(3, 12)},
+	}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: todoID, Name: "todo"}, {ID: doneID, Name: "done"}}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return tasks, nil
+		},
+	}
+	mtr := &mocks.TransitionRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Transition, error) {
+			return transitions, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, mtr, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
+	got, err := u.FetchCFD(context.TODO(), uuid.New(), day(1, 0), day(5, 0))
+	is.NoErr(err)
+	is.Equal(got.Dates, []string{"2026-10-01", "2026-10-02", "2026-10-03", "2026-10-04"})
+	is.Equal(got.Columns[0].Counts, []int{1, 2, 1, 1})
+	is.Equal(got.Columns[1].Counts, []int{0, 0, 1, 1})
+
+	_, err = u.FetchCFD(context.TODO(), uuid.New(), day(1, 0), day(1, 0).AddDate(2, 0, 0))
+	is.True(errors.Is(err, domain.ErrBadParamInput))
+}
+
+//nolint:exhaustivestruct
+func TestArchive(t *testing.T) {
+	is := helper.New(t)
+
+	project := domain.Project{ID: uuid.New(), Name: "test"}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return project, nil
+		},
+		SetArchivedAtFunc: func(ctx context.Context, pr *domain.Project) error {
+			project.ArchivedAt = pr.ArchivedAt
+
+			return nil
+		},
+	}
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
+	got, err := u.Archive(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.True(got.IsArchived())
+
+	_, err = u.Archive(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.Equal(len(mp.SetArchivedAtCalls()), 1)
+
+	err = u.Update(context.TODO(), &domain.Project{ID: project.ID, Name: "new"})
+	is.True(errors.Is(err, domain.ErrArchived))
+	err = u.StoreColumn(context.TODO(), &domain.Column{ProjectID: project.ID, Name: "new"})
+	is.True(errors.Is(err, domain.ErrArchived))
+
+	got, err = u.Unarchive(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.True(!got.IsArchived())
+	is.Equal(len(mp.SetArchivedAtCalls()), 2)
+}
+
+//nolint:exhaustivestruct
+func TestStoreFromTemplate(t *testing.T) {
+	is := helper.New(t)
+
+	limit := 3
+	template := domain.Template{ID: uuid.New(), Name: "kanban", Columns: []domain.TemplateColumn{
+		{Name: "Backlog", Status: "backlog"},
+		{Name: "Doing", Status: "doing", Category: domain.CategoryInProgress, WIPLimit: &limit},
+	}, Labels: []domain.Label{{Name: "bug", Color: "#d73a4a"}}}
+	mp := &mocks.ProjectRepositoryMock{
+		StoreWithColumnsFunc: func(ctx context.Context, pr *domain.Project, cls []domain.Column) error {
+			return nil
+		},
+	}
+	mtm := &mocks.TemplateRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+			if id != template.ID {
+				return domain.Template{}, domain.ErrNotFound
+			}
+
+			return template, nil
+		},
+	}
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, mtm, &mocks.CommentRepositoryMock{}, &mocks.TaskUsecaseMock{})
+
+	project := domain.Project{Name: "test"}
+	err := u.StoreFromTemplate(context.TODO(), &project, template.ID)
+	is.NoErr(err)
+	calls := mp.StoreWithColumnsCalls()
+	is.Equal(len(calls), 1)
+	is.Equal(calls[0].Pr, &project)
+	is.Equal(project.Labels, template.Labels)
+	is.Equal(calls[0].Cls, []domain.Column{
+		{Position: 0, Name: "Backlog", Status: "backlog", Category: domain.CategoryTodo},
+		{Position: 1, Name: "Doing", Status: "doing", Category: domain.CategoryInProgress, WIPLimit: &limit},
+	})
+
+	err = u.StoreFromTemplate(context.TODO(), &project, uuid.New())
+	is.True(errors.Is(err, domain.ErrNotFound))
+	is.Equal(len(mp.StoreWithColumnsCalls()), 1)
+}
+
+//nolint:exhaustivestruct
+func TestClone(t *testing.T) {
+	is := helper.New(t)
+
+	project := domain.Project{ID: uuid.New(), Name: "board", Description: "description"}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return project, nil
+		},
+		CloneFunc: func(ctx context.Context, id uuid.UUID, pr *domain.Project, opts domain.CloneOptions) error {
+			pr.ID = uuid.New()
+
+			return nil
+		},
+	}
+	u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+		&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+		&mocks.TaskUsecaseMock{})
+
+	tt := []struct {
+		name string
+		opts domain.CloneOptions
+		want domain.Project
+	}{
+		{"default name", domain.CloneOptions{Tasks: true}, domain.Project{Name: "board (copy)", Description: "description"}},
+		{"new name", domain.CloneOptions{Name: "new", Description: "new"}, domain.Project{Name: "new", Description: "new"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := u.Clone(context.TODO(), project.ID, tc.opts)
+			is.NoErr(err)
+			is.True(got.ID != uuid.Nil)
+			is.Equal(got.Name, tc.want.Name)
+			is.Equal(got.Description, tc.want.Description)
+		})
+	}
+	t.Run("comments without tasks", func(t *testing.T) {
+		_, err := u.Clone(context.TODO(), project.ID, domain.CloneOptions{Comments: true})
+		is.True(errors.Is(err, domain.ErrBadParamInput))
+	})
+	is.Equal(len(mp.CloneCalls()), 2)
+	is.Equal(mp.CloneCalls()[0].ID, project.ID)
+}
+
+//nolint:exhaustivestruct,funlen
+func TestExport(t *testing.T) {
+	is := helper.New(t)
+
+	project := domain.Project{ID: uuid.New(), Name: "board", Description: "description"}
+	todoID, doneID := uuid.New(), uuid.New()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	commentedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return project, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{
+				{ID: todoID, Name: "todo", Status: "todo", Category: domain.CategoryTodo},
+				{ID: doneID, Name: "done", Status: "done", Category: domain.CategoryDone},
+			}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{
+				{ID: second, Name: "second", Position: 1, ColumnID: todoID, ParentID: &first},
+				{ID: first, Name: "first", Position: 0, ColumnID: todoID},
+				{ID: third, Name: "third", Position: 0, ColumnID: doneID},
+			}, nil
+		},
+	}
+	mcm := &mocks.CommentRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+			return []domain.Comment{{Text: "note", TaskID: third, CreatedAt: commentedAt}}, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, &mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, mcm,
+		&mocks.TaskUsecaseMock{})
+	got, err := u.Export(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.Equal(got.Version, domain.ExportVersion)
+	is.Equal(got.Project, domain.ExportedProject{Name: "board", Description: "description"})
+	is.Equal(len(got.Columns), 2)
+	is.Equal(got.Columns[0].Tasks, []domain.ExportedTask{
+		{ID: first, Name: "first", Comments: []domain.ExportedComment{}},
+		{ID: second, Name: "second", ParentID: &first, Comments: []domain.ExportedComment{}},
+	})
+	is.Equal(got.Columns[1].Tasks, []domain.ExportedTask{
+		{ID: third, Name: "third", Comments: []domain.ExportedComment{{Text: "note", CreatedAt: commentedAt}}},
+	})
+}
+
+//nolint:exhaustivestruct,funlen
+func TestImport(t *testing.T) {
+	parentID, childID, unknownID := uuid.New(), uuid.New(), uuid.New()
+	valid := func() domain.Export {
+		return domain.Export{
+			Version: domain.ExportVersion,
+			Project: domain.ExportedProject{Name: "board"},
+			Columns: []domain.ExportedColumn{
+				{Name: "todo", Status: "todo", Tasks: []domain.ExportedTask{{ID: parentID, Name: "parent"}}},
+				{Name: "done", Status: "done", Tasks: []domain.ExportedTask{{ID: childID, Name: "child", ParentID: &parentID}}},
+			},
+		}
+	}
+	tt := []struct {
+		name   string
+		modify func(ex *domain.Export)
+		want   error
+	}{
+		{"valid", func(ex *domain.Export) {}, nil},
+		{"unknown version", func(ex *domain.Export) { ex.Version = 2 }, domain.ErrBadParamInput},
+		{"duplicate column name", func(ex *domain.Export) { ex.Columns[1].Name = "todo" }, domain.ErrUnique},
+		{"duplicate task id", func(ex *domain.Export) { ex.Columns[1].Tasks[0].ID = parentID }, domain.ErrUnique},
+		{"unknown parent", func(ex *domain.Export) { ex.Columns[1].Tasks[0].ParentID = &unknownID }, domain.ErrBadParamInput},
+		{"parent cycle", func(ex *domain.Export) { ex.Columns[0].Tasks[0].ParentID = &childID }, domain.ErrParentCycle},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mp := &mocks.ProjectRepositoryMock{
+				ImportFunc: func(ctx context.Context, pr *domain.Project, ex *domain.Export) error {
+					pr.ID = uuid.New()
+
+					return nil
+				},
+			}
+			u := projectUsecase.New(mp, &mocks.ColumnRepositoryMock{}, &mocks.TaskRepositoryMock{},
+				&mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, &mocks.CommentRepositoryMock{},
+				&mocks.TaskUsecaseMock{})
+			ex := valid()
+			tc.modify(&ex)
+			got, err := u.Import(context.TODO(), &ex)
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mp.ImportCalls()), 0)
+
+				return
+			}
+			is.Equal(got.Name, "board")
+			is.True(got.ID != uuid.Nil)
+			is.Equal(ex.Columns[0].Category, domain.CategoryTodo)
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestExportImportOrphan(t *testing.T) {
+	is := helper.New(t)
+
+	// The parent of the child task is in the trash, so it is not fetched with the board.
+	columnID, trashedID, childID := uuid.New(), uuid.New(), uuid.New()
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id, Name: "board"}, nil
+		},
+		ImportFunc: func(ctx context.Context, pr *domain.Project, ex *domain.Export) error {
+			pr.ID = uuid.New()
+
+			return nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: columnID, Name: "todo", Status: "todo"}}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{{ID: childID, Name: "child", ColumnID: columnID, ParentID: &trashedID}}, nil
+		},
+	}
+	mcm := &mocks.CommentRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+			return nil, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, &mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, mcm,
+		&mocks.TaskUsecaseMock{})
+
+	ex, err := u.Export(context.TODO(), uuid.New())
+	is.NoErr(err)
+	is.Equal(ex.Columns[0].Tasks[0].ParentID, nil)
+
+	_, err = u.Import(context.TODO(), &ex)
+	is.NoErr(err)
+	is.Equal(len(mp.ImportCalls()), 1)
+	is.Equal(mp.ImportCalls()[0].Ex.Columns[0].Tasks[0].ID, childID)
+}
+
+//nolint:exhaustivestruct
+func TestFetchTaskRows(t *testing.T) {
+	is := helper.New(t)
+
+	todoID, doneID, taskID := uuid.New(), uuid.New(), uuid.New()
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: todoID, Name: "todo"}, {ID: doneID, Name: "done"}}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{
+				{Name: "c", Position: 0, ColumnID: doneID},
+				{Name: "b", Position: 1, ColumnID: todoID},
+				{ID: taskID, Name: "a", Position: 0, ColumnID: todoID, Description: "d"},
+			}, nil
+		},
+	}
+	mcm := &mocks.CommentRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+			return []domain.Comment{{TaskID: taskID}, {TaskID: taskID}}, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, &mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, mcm,
+		&mocks.TaskUsecaseMock{})
+	rows, err := u.FetchTaskRows(context.TODO(), uuid.New())
+	is.NoErr(err)
+	is.Equal(rows, []domain.TaskRow{
+		{Column: "todo", Position: 0, Name: "a", Description: "d", Comments: 2},
+		{Column: "todo", Position: 1, Name: "b"},
+		{Column: "done", Position: 0, Name: "c"},
+	})
+}
+
+//nolint:exhaustivestruct,funlen
+func TestImportTasks(t *testing.T) {
+	is := helper.New(t)
+
+	id, todoID, doneID := uuid.New(), uuid.New(), uuid.New()
+	columns := []domain.Column{
+		{ID: todoID, Name: "todo", Status: "todo", Category: domain.CategoryTodo},
+		{ID: doneID, Name: "done", Status: "done", Category: domain.CategoryDone},
+	}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return columns, nil
+		},
+		StoreFunc: func(ctx context.Context, c *domain.Column) error {
+			c.ID = uuid.New()
+
+			return nil
+		},
+	}
+	mtu := &mocks.TaskUsecaseMock{
+		StoreFunc: func(ctx context.Context, tk *domain.Task) error {
+			switch {
+			case tk.Name == "over limit":
+				return domain.ErrWIPLimit
+			case tk.Name == "fail":
+				return errors.New("some error")
+			}
+			tk.ID = uuid.New()
+
+			return nil
+		},
+	}
+	u := projectUsecase.New(
+		mp, mc, &mocks.TaskRepositoryMock{}, &mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{},
+		&mocks.CommentRepositoryMock{}, mtu,
+	)
+	res, err := u.ImportTasks(context.TODO(), id, []domain.TaskRow{
+		{Row: 2, Column: "todo", Name: "first", Description: "text"},
+		{Row: 3, Column: "done", Name: "second"},
+		{Row: 4, Column: "done", Name: "over limit"},
+		{Row: 5, Column: "review", Name: "third"},
+		{Row: 6, Column: "review", Name: "fail"},
+		{Row: 7, Column: "todo", Name: "fourth"},
+	})
+	is.NoErr(err)
+	is.Equal(res.Imported, 4)
+	is.Equal(len(res.Errors), 2)
+	is.Equal(res.Errors[0].Row, 4)
+	is.True(strings.Contains(res.Errors[0].Error, domain.ErrWIPLimit.Error()))
+	is.Equal(res.Errors[1].Row, 6)
+
+	cs := mc.StoreCalls()
+	is.Equal(len(cs), 1)
+	is.Equal(cs[0].C.Name, "review")
+	is.Equal(cs[0].C.Position, 2)
+	calls := mtu.StoreCalls()
+	is.Equal(len(calls), 6)
+	is.Equal(calls[0].In2.Description, "text")
+	for i, columnID := range []uuid.UUID{todoID, doneID, doneID, cs[0].C.ID, cs[0].C.ID, todoID} {
+		is.Equal(calls[i].In2.ColumnID, columnID)
+	}
+	is.Equal(res.Tasks[2].ColumnID, cs[0].C.ID)
+
+	mp.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+		archivedAt := time.Now()
+
+		return domain.Project{ID: id, ArchivedAt: &archivedAt}, nil
+	}
+	_, err = u.ImportTasks(context.TODO(), id, []domain.TaskRow{{Row: 2, Column: "todo", Name: "first"}})
+	is.True(errors.Is(err, domain.ErrArchived))
+	is.Equal(len(mtu.StoreCalls()), 6)
+}
+
+//nolint:exhaustivestruct
+func TestFetchReport(t *testing.T) {
+	is := helper.New(t)
+
+	project := domain.Project{ID: uuid.New(), Name: "board"}
+	todoID, doneID := uuid.New(), uuid.New()
+	first, second := uuid.New(), uuid.New()
+	at := func(h int) time.Time { return time.Date(2026, 10, 1, h, 0, 0, 0, time.UTC) }
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return project, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: todoID, Name: "todo"}, {ID: doneID, Name: "done"}}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{
+				{ID: second, Name: "second", Position: 1, ColumnID: todoID},
+				{ID: first, Name: "first", Position: 0, ColumnID: todoID},
+			}, nil
+		},
+	}
+	mcm := &mocks.CommentRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
+			return []domain.Comment{
+				{Text: "1", TaskID: first, CreatedAt: at(1)},
+				{Text: "2", TaskID: first, CreatedAt: at(2)},
+				{Text: "3", TaskID: first, CreatedAt: at(3)},
+				{Text: "4", TaskID: first, CreatedAt: at(4)},
+			}, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, &mocks.TransitionRepositoryMock{}, &mocks.TemplateRepositoryMock{}, mcm,
+		&mocks.TaskUsecaseMock{})
+	got, err := u.FetchReport(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.Equal(got.Project, project)
+	is.Equal(len(got.Columns), 2)
+	is.Equal(got.Columns[0].Column.Name, "todo")
+	is.Equal(len(got.Columns[0].Tasks), 2)
+	is.Equal(got.Columns[0].Tasks[0].Task.Name, "first")
+	is.Equal(got.Columns[0].Tasks[1].Task.Name, "second")
+	texts := make([]string, 0, len(got.Columns[0].Tasks[0].Comments))
+	for _, cm := range got.Columns[0].Tasks[0].Comments {
+		texts = append(texts, cm.Text)
+	}
+	is.Equal(texts, []string{"4", "3", "2"})
+	is.Equal(len(got.Columns[1].Tasks), 0)
+
+	mp.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+		return domain.Project{}, domain.ErrNotFound
+	}
+	_, err = u.FetchReport(context.TODO(), project.ID)
+	is.True(errors.Is(err, domain.ErrNotFound))
+}
+
+//nolint:exhaustivestruct
+func TestFetchActivity(t *testing.T) {
+	is := helper.New(t)
+
+	project := domain.Project{ID: uuid.New(), Name: "board"}
+	todoID, doneID := uuid.New(), uuid.New()
+	taskID, movedID, commentID := uuid.New(), uuid.New(), uuid.New()
+	at := func(h int) time.Time { return time.Date(2026, 10, 1, h, 0, 0, 0, time.UTC) }
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return project, nil
+		},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{{ID: todoID, Name: "todo"}, {ID: doneID, Name: "done"}}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return []domain.Task{{ID: taskID, Name: "task", ColumnID: doneID, CreatedAt: at(1)}}, nil
+		},
+	}
+	mtr := &mocks.TransitionRepositoryMock{
+		FetchRecentByProjectIDFunc: func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Transition, error) {
+			return []domain.Transition{
+				{ID: uuid.New(), TaskID: uuid.New(), FromColumnID: &todoID, ToColumnID: doneID, CreatedAt: at(4)},
+				{ID: movedID, TaskID: taskID, FromColumnID: &todoID, ToColumnID: doneID, CreatedAt: at(3)},
+				{ID: uuid.New(), TaskID: taskID, ToColumnID: todoID, CreatedAt: at(1)},
+			}, nil
+		},
+	}
+	mcm := &mocks.CommentRepositoryMock{
+		FetchRecentByProjectIDFunc: func(ctx context.Context, id uuid.UUID, limit int) ([]domain.Comment, error) {
+			return []domain.Comment{{ID: commentID, Text: "note", TaskID: taskID, CreatedAt: at(2), UpdatedAt: at(5)}}, nil
+		},
+	}
+	u := projectUsecase.New(mp, mc, mt, mtr, &mocks.TemplateRepositoryMock{}, mcm, &mocks.TaskUsecaseMock{})
+	got, err := u.FetchActivity(context.TODO(), project.ID)
+	is.NoErr(err)
+	is.Equal(got.Project, project)
+	is.Equal(len(got.Activities), 3)
+
+	is.Equal(got.Activities[0].ID, movedID)
+	is.Equal(got.Activities[0].Kind, domain.ActivityTaskMoved)
+	is.Equal(got.Activities[0].FromColumn.Name, "todo")
+	is.Equal(got.Activities[0].Column.Name, "done")
+
+	is.Equal(got.Activities[1].ID, commentID)
+	is.Equal(got.Activities[1].Kind, domain.ActivityCommentAdded)
+	is.Equal(got.Activities[1].Comment.Text, "note")
+	is.Equal(got.Activities[1].UpdatedAt, at(5))
+
+	is.Equal(got.Activities[2].ID, taskID)
+	is.Equal(got.Activities[2].Kind, domain.ActivityTaskCreated)
+	// The task was created in the todo column before it moved to done.
+	is.Equal(got.Activities[2].Column.Name, "todo")
+	is.Equal(got.Activities[2].CreatedAt, at(1))
+
+	is.Equal(mtr.FetchRecentByProjectIDCalls()[0].Limit, 50)
+	is.Equal(mcm.FetchRecentByProjectIDCalls()[0].Limit, 50)
+
+	mp.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+		return domain.Project{}, domain.ErrNotFound
+	}
+	_, err = u.FetchActivity(context.TODO(), project.ID)
+	is.True(errors.Is(err, domain.ErrNotFound))
+}
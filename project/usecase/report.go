@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// reportComments is the number of the most recent comments of a task in a report.
+const reportComments = 3
+
+func (p *projectUsecase) FetchReport(ctx context.Context, id uuid.UUID) (domain.Report, error) {
+	b, err := p.fetchBoardWithComments(ctx, id)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	return report(b, time.Now().UTC()), nil
+}
+
+func report(b board, now time.Time) domain.Report {
+	rp := domain.Report{Project: b.project, Columns: make([]domain.ReportColumn, 0, len(b.columns)), GeneratedAt: now}
+	for _, c := range b.columns {
+		var tasks []domain.ReportTask
+		for _, tk := range b.tasks[c.ID] {
+			tasks = append(tasks, domain.ReportTask{Task: tk, Comments: recentComments(b.comments[tk.ID])})
+		}
+		rp.Columns = append(rp.Columns, domain.ReportColumn{Column: c, Tasks: tasks})
+	}
+
+	return rp
+}
+
+// recentComments returns the last reportComments of the comments, the newest first.
+func recentComments(comments []domain.Comment) []domain.Comment {
+	var recent []domain.Comment
+	for i := len(comments) - 1; i >= 0 && len(recent) < reportComments; i-- {
+		recent = append(recent, comments[i])
+	}
+
+	return recent
+}
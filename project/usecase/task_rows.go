@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+// FetchTaskRows returns the tasks of the project as spreadsheet rows in board order.
+func (p *projectUsecase) FetchTaskRows(ctx context.Context, id uuid.UUID) ([]domain.TaskRow, error) {
+	b, err := p.fetchBoardWithComments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return taskRows(b), nil
+}
+
+func taskRows(b board) []domain.TaskRow {
+	rows := make([]domain.TaskRow, 0)
+	for _, c := range b.columns {
+		for _, tk := range b.tasks[c.ID] {
+			rows = append(rows, domain.TaskRow{
+				Row:         0,
+				Column:      c.Name,
+				Position:    tk.Position,
+				Name:        tk.Name,
+				Description: tk.Description,
+				Comments:    len(b.comments[tk.ID]),
+			})
+		}
+	}
+
+	return rows
+}
+
+// ImportTasks appends the tasks of the rows to the columns named by the rows in row order.
+// The missing columns are created by StoreColumn and the tasks by the Store of the TaskUsecase.
+// The rows that cannot be imported are reported in the result and do not stop the import of the other rows.
+func (p *projectUsecase) ImportTasks(
+	ctx context.Context,
+	id uuid.UUID,
+	rows []domain.TaskRow,
+) (domain.TaskImport, error) {
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.TaskImport{}, fmt.Errorf("get project by id: %w", err)
+	}
+	if project.IsArchived() {
+		return domain.TaskImport{}, domain.ErrArchived
+	}
+	columns, err := p.columnRepo.FetchByProjectID(ctx, id)
+	if err != nil {
+		return domain.TaskImport{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	byName := make(map[string]domain.Column, len(columns))
+	for _, c := range columns {
+		byName[c.Name] = c
+	}
+	res := domain.TaskImport{Imported: 0, Tasks: []domain.Task{}, Errors: []domain.RowError{}}
+	for _, row := range rows {
+		tk, err := p.importTaskRow(ctx, id, row, byName)
+		if err != nil {
+			res.Errors = append(res.Errors, domain.RowError{Row: row.Row, Error: err.Error()})
+
+			continue
+		}
+		res.Tasks = append(res.Tasks, tk)
+	}
+	res.Imported = len(res.Tasks)
+
+	return res, nil
+}
+
+func (p *projectUsecase) importTaskRow(
+	ctx context.Context,
+	id uuid.UUID,
+	row domain.TaskRow,
+	byName map[string]domain.Column,
+) (domain.Task, error) {
+	column, ok := byName[row.Column]
+	if !ok {
+		// nolint:exhaustivestruct
+		column = domain.Column{Name: row.Column, Status: row.Column, Position: len(byName), ProjectID: id}
+		if err := p.StoreColumn(ctx, &column); err != nil {
+			return domain.Task{}, fmt.Errorf("store column %q: %w", row.Column, err)
+		}
+		byName[row.Column] = column
+	}
+	// Store appends the task when its position is past the last task of the column.
+	// nolint:exhaustivestruct
+	tk := domain.Task{Name: row.Name, Description: row.Description, ColumnID: column.ID, Position: math.MaxInt32}
+	if err := p.taskUsecase.Store(ctx, &tk); err != nil {
+		return domain.Task{}, fmt.Errorf("column %q: %w", row.Column, err)
+	}
+
+	return tk, nil
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package trackerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// ColumnServiceClient is the client API for ColumnService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ColumnServiceClient interface {
+	GetColumn(ctx context.Context, in *GetColumnRequest, opts ...grpc.CallOption) (*Column, error)
+	UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*Column, error)
+	DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error)
+	ListColumnTasks(ctx context.Context, in *ListColumnTasksRequest, opts ...grpc.CallOption) (*ListColumnTasksResponse, error)
+}
+
+type columnServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewColumnServiceClient(cc grpc.ClientConnInterface) ColumnServiceClient {
+	return &columnServiceClient{cc}
+}
+
+func (c *columnServiceClient) GetColumn(ctx context.Context, in *GetColumnRequest, opts ...grpc.CallOption) (*Column, error) {
+	out := new(Column)
+	err := c.cc.Invoke(ctx, "/tasktracker.v1.ColumnService/GetColumn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *columnServiceClient) UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*Column, error) {
+	out := new(Column)
+	err := c.cc.Invoke(ctx, "/tasktracker.v1.ColumnService/UpdateColumn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *columnServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error) {
+	out := new(DeleteColumnResponse)
+	err := c.cc.Invoke(ctx, "/tasktracker.v1.ColumnService/DeleteColumn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *columnServiceClient) ListColumnTasks(ctx context.Context, in *ListColumnTasksRequest, opts ...grpc.CallOption) (*ListColumnTasksResponse, error) {
+	out := new(ListColumnTasksResponse)
+	err := c.cc.Invoke(ctx, "/tasktracker.v1.ColumnService/ListColumnTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ColumnServiceServer is the server API for ColumnService service.
+// All implementations must embed UnimplementedColumnServiceServer
+// for forward compatibility
+type ColumnServiceServer interface {
+	GetColumn(context.Context, *GetColumnRequest) (*Column, error)
+	UpdateColumn(context.Context, *UpdateColumnRequest) (*Column, error)
+	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	ListColumnTasks(context.Context, *ListColumnTasksRequest) (*ListColumnTasksResponse, error)
+	mustEmbedUnimplementedColumnServiceServer()
+}
+
+// UnimplementedColumnServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedColumnServiceServer struct {
+}
+
+func (UnimplementedColumnServiceServer) GetColumn(context.Context, *GetColumnRequest) (*Column, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetColumn not implemented")
+}
+func (UnimplementedColumnServiceServer) UpdateColumn(context.Context, *UpdateColumnRequest) (*Column, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateColumn not implemented")
+}
+func (UnimplementedColumnServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteColumn not implemented")
+}
+func (UnimplementedColumnServiceServer) ListColumnTasks(context.Context, *ListColumnTasksRequest) (*ListColumnTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListColumnTasks not implemented")
+}
+func (UnimplementedColumnServiceServer) mustEmbedUnimplementedColumnServiceServer() {}
+
+// UnsafeColumnServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ColumnServiceServer will
+// result in compilation errors.
+type UnsafeColumnServiceServer interface {
+	mustEmbedUnimplementedColumnServiceServer()
+}
+
+func RegisterColumnServiceServer(s grpc.ServiceRegistrar, srv ColumnServiceServer) {
+	s.RegisterService(&_ColumnService_serviceDesc, srv)
+}
+
+func _ColumnService_GetColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnServiceServer).GetColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasktracker.v1.ColumnService/GetColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnServiceServer).GetColumn(ctx, req.(*GetColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColumnService_UpdateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnServiceServer).UpdateColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasktracker.v1.ColumnService/UpdateColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnServiceServer).UpdateColumn(ctx, req.(*UpdateColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColumnService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnServiceServer).DeleteColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasktracker.v1.ColumnService/DeleteColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnServiceServer).DeleteColumn(ctx, req.(*DeleteColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColumnService_ListColumnTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColumnTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnServiceServer).ListColumnTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasktracker.v1.ColumnService/ListColumnTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnServiceServer).ListColumnTasks(ctx, req.(*ListColumnTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ColumnService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tasktracker.v1.ColumnService",
+	HandlerType: (*ColumnServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetColumn",
+			Handler:    _ColumnService_GetColumn_Handler,
+		},
+		{
+			MethodName: "UpdateColumn",
+			Handler:    _ColumnService_UpdateColumn_Handler,
+		},
+		{
+			MethodName: "DeleteColumn",
+			Handler:    _ColumnService_DeleteColumn_Handler,
+		},
+		{
+			MethodName: "ListColumnTasks",
+			Handler:    _ColumnService_ListColumnTasks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tasktracker/v1/column.proto",
+}
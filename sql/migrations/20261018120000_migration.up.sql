@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id UUID;
+ALTER TABLE tasks ADD FOREIGN KEY (parent_id) REFERENCES tasks(id) ON DELETE SET NULL;
+
+COMMIT;
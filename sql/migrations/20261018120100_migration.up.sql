@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS task_links (
+  id UUID DEFAULT uuid_generate_v4(),
+  type varchar(32) NOT NULL,
+  task_id UUID NOT NULL,
+  linked_task_id UUID NOT NULL,
+  FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  FOREIGN KEY (linked_task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  UNIQUE (task_id, linked_task_id, type),
+
+  PRIMARY KEY (id)
+);
+
+COMMIT;
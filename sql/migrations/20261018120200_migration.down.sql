@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS wip_overrides;
+ALTER TABLE columns DROP COLUMN IF EXISTS wip_limit;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE columns ADD COLUMN IF NOT EXISTS wip_limit INTEGER CHECK (wip_limit > 0);
+
+CREATE TABLE IF NOT EXISTS wip_overrides (
+  id UUID DEFAULT uuid_generate_v4(),
+  task_id UUID NOT NULL,
+  column_id UUID NOT NULL,
+  wip_limit INTEGER NOT NULL,
+  count INTEGER NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  FOREIGN KEY (column_id) REFERENCES columns(id) ON DELETE CASCADE,
+
+  PRIMARY KEY (id)
+);
+
+COMMIT;
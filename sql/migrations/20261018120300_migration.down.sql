@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE columns DROP COLUMN IF EXISTS category;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE columns ADD COLUMN IF NOT EXISTS category varchar(32) NOT NULL DEFAULT 'todo'
+  CHECK (category IN ('todo', 'in-progress', 'done'));
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS set_timestamp ON comments;
+DROP TRIGGER IF EXISTS set_timestamp ON tasks;
+DROP TRIGGER IF EXISTS set_timestamp ON columns;
+DROP TRIGGER IF EXISTS set_timestamp ON projects;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE comments ALTER COLUMN created_at DROP NOT NULL;
+ALTER TABLE comments ALTER COLUMN created_at DROP DEFAULT;
+ALTER TABLE comments RENAME COLUMN created_at TO date_created;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS created_at;
+ALTER TABLE columns DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE columns DROP COLUMN IF EXISTS created_at;
+ALTER TABLE projects DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE projects DROP COLUMN IF EXISTS created_at;
+
+COMMIT;
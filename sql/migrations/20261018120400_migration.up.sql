@@ -0,0 +1,28 @@
+BEGIN;
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE columns ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE columns ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+ALTER TABLE comments RENAME COLUMN date_created TO created_at;
+UPDATE comments SET created_at = NOW() WHERE created_at IS NULL;
+ALTER TABLE comments ALTER COLUMN created_at SET DEFAULT NOW();
+ALTER TABLE comments ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP;
+UPDATE comments SET updated_at = created_at;
+ALTER TABLE comments ALTER COLUMN updated_at SET DEFAULT NOW();
+ALTER TABLE comments ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE TRIGGER set_timestamp BEFORE UPDATE ON projects
+  FOR EACH ROW EXECUTE PROCEDURE trigger_update_timestamp();
+CREATE TRIGGER set_timestamp BEFORE UPDATE ON columns
+  FOR EACH ROW EXECUTE PROCEDURE trigger_update_timestamp();
+CREATE TRIGGER set_timestamp BEFORE UPDATE ON tasks
+  FOR EACH ROW EXECUTE PROCEDURE trigger_update_timestamp();
+CREATE TRIGGER set_timestamp BEFORE UPDATE ON comments
+  FOR EACH ROW EXECUTE PROCEDURE trigger_update_timestamp();
+
+COMMIT;
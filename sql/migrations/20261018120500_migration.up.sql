@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS task_transitions (
+  id UUID DEFAULT uuid_generate_v4(),
+  task_id UUID NOT NULL,
+  from_column_id UUID,
+  to_column_id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS task_transitions_task_id_idx ON task_transitions (task_id, created_at);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+DELETE FROM projects WHERE deleted_at IS NOT NULL;
+DELETE FROM columns WHERE deleted_at IS NOT NULL;
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+DELETE FROM comments WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS tasks_deleted_at_idx;
+DROP INDEX IF EXISTS columns_deleted_at_idx;
+DROP INDEX IF EXISTS projects_deleted_at_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE columns DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE projects DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
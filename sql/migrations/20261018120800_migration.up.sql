@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS templates (
+  id UUID DEFAULT uuid_generate_v4(),
+  name varchar(255) NOT NULL,
+  description varchar(1000) NOT NULL DEFAULT '',
+  columns JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (id)
+);
+
+CREATE TRIGGER set_timestamp BEFORE UPDATE ON templates
+  FOR EACH ROW EXECUTE PROCEDURE trigger_update_timestamp();
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE templates ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '[]';
+
+COMMIT;
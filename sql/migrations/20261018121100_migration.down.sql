@@ -0,0 +1,5 @@
+BEGIN;
+
+-- The backfilled transitions cannot be told apart from the recorded ones, so they are kept.
+
+COMMIT;
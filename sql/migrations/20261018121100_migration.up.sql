@@ -0,0 +1,9 @@
+BEGIN;
+
+-- The activity feed takes the creation of a task from its first transition. The tasks created
+-- before the transitions were recorded get one into their current column at their creation time.
+INSERT INTO task_transitions (task_id, to_column_id, created_at)
+SELECT t.id, t.colum_id, t.created_at FROM tasks t
+WHERE NOT EXISTS (SELECT 1 FROM task_transitions tr WHERE tr.task_id = t.id AND tr.from_column_id IS NULL);
+
+COMMIT;
@@ -1,11 +1,14 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/url"
 
+	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/configs"
+	"github.com/igkostyuk/tasktracker/domain"
 
 	// The database driver in use.
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -40,3 +43,48 @@ func Open(cfg configs.Postgres) (*sql.DB, error) {
 
 	return db, nil
 }
+
+// OrderBy returns the ORDER BY expression of the filter sort key
+// falling back to the def column when the filter has no known key.
+func OrderBy(f domain.Filter, def string) string {
+	column := def
+	switch f.SortBy {
+	case domain.SortCreatedAt, domain.SortUpdatedAt:
+		column = f.SortBy
+	}
+	if f.Desc {
+		return column + " DESC"
+	}
+
+	return column
+}
+
+// IDs returns the ids as strings for an uuid[] query param.
+func IDs(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+
+	return result
+}
+
+// WithTx runs fn in a transaction that is committed when fn succeeds and rolled back otherwise.
+func WithTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("tx begin: %w", err)
+	}
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx rollback: %v: %w", rbErr, err)
+		}
+
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("tx commit: %w", err)
+	}
+
+	return nil
+}
@@ -2,14 +2,13 @@ package router
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi"
-	"github.com/go-playground/validator"
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/middleware"
 	"github.com/igkostyuk/tasktracker/internal/web"
 )
 
@@ -28,9 +27,15 @@ func New(us domain.TaskUsecase) chi.Router {
 	r.Route("/{taskID}", func(r chi.Router) {
 		r.Get("/", handler.GetByID)
 		r.Put("/", handler.Update)
+		r.Patch("/", handler.Patch)
 		r.Delete("/", handler.Delete)
+		r.Post("/restore", handler.Restore)
 		r.Get("/comments", handler.FetchComments)
 		r.Post("/comments", handler.StoreComment)
+		r.Get("/children", handler.FetchChildren)
+		r.Get("/links", handler.FetchLinks)
+		r.Post("/links", handler.StoreLink)
+		r.Delete("/links/{linkID}", handler.DeleteLink)
 	})
 
 	return r
@@ -41,16 +46,25 @@ func New(us domain.TaskUsecase) chi.Router {
 // @Description get all tasks
 // @Tags tasks
 // @Produce  json
+// @Param sort query string false "sort key created_at or updated_at, prefixed with - for descending order"
+// @Param modified_since query string false "only items updated since the time" format(date-time)
 // @Success 200 {array} domain.Task
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks [get]
 // Fetch will fetch tasks.
 func (t *taskHandler) Fetch(w http.ResponseWriter, r *http.Request) {
-	tasks, err := t.taskUsecase.Fetch(r.Context())
+	f, err := web.ParseFilter(r)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
+
+		return
+	}
+	tasks, err := t.taskUsecase.Fetch(r.Context(), f)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -64,30 +78,171 @@ func (t *taskHandler) Fetch(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "task ID" format(uuid)
 // @Success 200 {array} domain.Task
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks/{id}/comments [get]
 // FetchComments will fetch tasks by task id.
 func (t *taskHandler) FetchComments(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	comments, err := t.taskUsecase.FetchComments(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
 	web.Respond(w, r, comments, http.StatusOK)
 }
 
+// FetchChildren godoc
+// @Summary Get children by task id
+// @Description get child tasks with their progress by parent task id
+// @Tags tasks
+// @Produce  json
+// @Param  id path string true "task ID" format(uuid)
+// @Success 200 {object} domain.TaskChildren
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id}/children [get]
+// FetchChildren will fetch child tasks by parent task id.
+func (t *taskHandler) FetchChildren(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	children, err := t.taskUsecase.FetchChildren(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, children, http.StatusOK)
+}
+
+// FetchLinks godoc
+// @Summary Get links by task id
+// @Description get links of the task in both directions by task id
+// @Tags links
+// @Produce  json
+// @Param  id path string true "task ID" format(uuid)
+// @Success 200 {array} domain.Link
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id}/links [get]
+// FetchLinks will fetch links by task id.
+func (t *taskHandler) FetchLinks(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	links, err := t.taskUsecase.FetchLinks(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, links, http.StatusOK)
+}
+
+func isLinkRequestValid(m *domain.Link) (bool, error) {
+	err := web.Validate(m)
+	if err != nil {
+		return false, fmt.Errorf("validation: %w", err)
+	}
+
+	return true, nil
+}
+
+// StoreLink godoc
+// @Summary Add a link
+// @Description add by json link to another task
+// @Tags links
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "task ID" format(uuid)
+// @Param link body domain.Link true "Add link"
+// @Success 200 {object} domain.Link
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id}/links [post]
+// StoreLink will store the link by given request body.
+func (t *taskHandler) StoreLink(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	var link domain.Link
+	if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	link.TaskID = id
+	if ok, err := isLinkRequestValid(&link); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := t.taskUsecase.StoreLink(r.Context(), &link); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, link, http.StatusOK)
+}
+
+// DeleteLink godoc
+// @Summary Delete a link
+// @Description Delete by task ID and link ID
+// @Tags links
+// @Produce  json
+// @Param  id path string true "task ID"
+// @Param  linkID path string true "link ID"
+// @Success 204 "it's ok"
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id}/links/{linkID} [delete]
+// DeleteLink will delete link by given params.
+func (t *taskHandler) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	linkID, err := uuid.Parse(chi.URLParam(r, "linkID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if err := t.taskUsecase.DeleteLink(r.Context(), id, linkID); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func isCommentRequestValid(m *domain.Comment) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -104,34 +259,34 @@ func isCommentRequestValid(m *domain.Comment) (bool, error) {
 // @Param  id path string true "task ID" format(uuid)
 // @Param project body domain.Comment true "Add comment"
 // @Success 200 {object} domain.Comment
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks/{id}/comments [post]
 // Store will store the comment by given request body.
 func (t *taskHandler) StoreComment(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var comment domain.Comment
 	if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	comment.TaskID = id
 	if ok, err := isCommentRequestValid(&comment); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
 	if err := t.taskUsecase.StoreComment(r.Context(), &comment); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -146,35 +301,119 @@ func (t *taskHandler) StoreComment(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "task ID" format(uuid)
 // @Param project body domain.Task true "Update task"
+// @Param override_wip query bool false "move over the column WIP limit, admin only"
 // @Success 200 {object} domain.Task
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 403 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks/{id} [put]
 // Update will update the task by given request body.
 func (t *taskHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	var task domain.Task
 	if err := json.NewDecoder(r.Body).Decode(&task); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	task.ID = id
+	if ok, err := isRequestValid(&task); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	ctx, err := middleware.WIPOverride(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := t.taskUsecase.Update(ctx, &task); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	// Read the task back to respond with the timestamps set by the database.
+	task, err = t.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, task, http.StatusOK)
+}
+
+// Patch godoc
+// @Summary Patch a task
+// @Description update the task by a JSON merge patch applied on top of the stored task
+// @Tags tasks
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param  id path string true "task ID" format(uuid)
+// @Param patch body domain.Task true "JSON merge patch of the task"
+// @Param override_wip query bool false "move over the column WIP limit, admin only"
+// @Success 200 {object} domain.Task
+// @Failure 400 {object} web.Problem
+// @Failure 403 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 415 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id} [patch]
+// Patch will update the task by the JSON merge patch of the request body.
+func (t *taskHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if !web.IsMergePatch(r) {
+		web.RespondRequestError(w, r, web.ErrMergePatchType, http.StatusUnsupportedMediaType)
+
+		return
+	}
+	task, err := t.taskUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := web.MergePatch(&task, r.Body); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	task.ID = id
 	if ok, err := isRequestValid(&task); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
+
+		return
+	}
+	ctx, err := middleware.WIPOverride(r)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
-	if err := t.taskUsecase.Update(r.Context(), &task); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+	if err := t.taskUsecase.Update(ctx, &task); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	// Read the task back to respond with the timestamps set by the database.
+	task, err = t.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -188,21 +427,21 @@ func (t *taskHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param  id path string true "task ID" format(uuid)
 // @Success 200 {object} domain.Task
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks/{id} [get]
 // GetByID will get task by given id.
 func (t *taskHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	task, err := t.taskUsecase.GetByID(r.Context(), id)
 	if err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -210,8 +449,7 @@ func (t *taskHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func isRequestValid(m *domain.Task) (bool, error) {
-	validate := validator.New()
-	err := validate.Struct(m)
+	err := web.Validate(m)
 	if err != nil {
 		return false, fmt.Errorf("validation: %w", err)
 	}
@@ -226,32 +464,36 @@ func isRequestValid(m *domain.Task) (bool, error) {
 // @Accept  json
 // @Produce  json
 // @Param project body domain.Task true "Add task"
+// @Param override_wip query bool false "add over the column WIP limit, admin only"
 // @Success 200 {object} domain.Task
-// @Failure 400 {object} web.HTTPError
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 422 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 400 {object} web.Problem
+// @Failure 403 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks [post]
 // Store will store the task by given request body.
 func (t *taskHandler) Store(w http.ResponseWriter, r *http.Request) {
 	var task domain.Task
 	if err := json.NewDecoder(r.Body).Decode(&task); err != nil {
-		web.RespondError(w, r, err, http.StatusUnprocessableEntity)
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
 
 		return
 	}
 	if ok, err := isRequestValid(&task); !ok {
-		web.RespondError(w, r, err, http.StatusBadRequest)
+		web.RespondError(w, r, err)
 
 		return
 	}
-	if err := t.taskUsecase.Store(r.Context(), &task); err != nil {
-		sc := getStatusCode(err)
-		if errors.Is(domain.ErrNotFound, err) {
-			sc = http.StatusBadRequest
-		}
-		web.RespondError(w, r, err, sc)
+	ctx, err := middleware.WIPOverride(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := t.taskUsecase.Store(ctx, &task); err != nil {
+		web.RespondError(w, r, err)
 
 		return
 	}
@@ -260,42 +502,63 @@ func (t *taskHandler) Store(w http.ResponseWriter, r *http.Request) {
 
 // Delete godoc
 // @Summary Delete a task
-// @Description Delete by task ID
+// @Description Move the task with its comments to the trash
 // @Tags tasks
 // @Produce  json
 // @Param  id path string true "task ID"
 // @Success 204 "it's ok"
-// @Failure 404 {object} web.HTTPError
-// @Failure 409 {object} web.HTTPError
-// @Failure 500 {object} web.HTTPError
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
 // @Router /tasks/{id} [delete]
 // Delete will delete task by given param.
 func (t *taskHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
 	if err != nil {
-		web.RespondError(w, r, domain.ErrNotFound, http.StatusNotFound)
+		web.RespondError(w, r, domain.ErrNotFound)
 
 		return
 	}
 	if err := t.taskUsecase.Delete(r.Context(), id); err != nil {
-		web.RespondError(w, r, err, getStatusCode(err))
+		web.RespondError(w, r, err)
 
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func getStatusCode(err error) int {
-	if err == nil {
-		return http.StatusOK
+// Restore godoc
+// @Summary Restore a task
+// @Description restore a deleted task as the last one of its column
+// @Tags tasks
+// @Produce  json
+// @Param  id path string true "task ID" format(uuid)
+// @Param override_wip query bool false "place the task over the column WIP limit, admin only"
+// @Success 200 {object} domain.Task
+// @Failure 403 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /tasks/{id}/restore [post]
+// Restore will restore the deleted task by given param.
+func (t *taskHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "taskID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	ctx, err := middleware.WIPOverride(r)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
 	}
+	task, err := t.taskUsecase.Restore(ctx, id)
+	if err != nil {
+		web.RespondError(w, r, err)
 
-	switch {
-	case errors.Is(err, domain.ErrNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrConflict):
-		return http.StatusConflict
-	default:
-		return http.StatusInternalServerError
+		return
 	}
+	web.Respond(w, r, task, http.StatusOK)
 }
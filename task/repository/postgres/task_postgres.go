@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
+	store "github.com/igkostyuk/tasktracker/store/postgres"
 )
 
 type taskRepository struct {
@@ -34,6 +36,11 @@ func (t *taskRepository) fetch(ctx context.Context, query string, args ...interf
 			&t.Name,
 			&t.Description,
 			&t.ColumnID,
+			&t.ParentID,
+			&t.CompletedAt,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("rows scan error: %w", err)
@@ -48,21 +55,47 @@ func (t *taskRepository) fetch(ctx context.Context, query string, args ...interf
 	return result, nil
 }
 
-func (t *taskRepository) Fetch(ctx context.Context) ([]domain.Task, error) {
-	query := `SELECT id, position, name, description, colum_id FROM tasks ORDER BY position`
+func (t *taskRepository) Fetch(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
+	// nolint:gosec // order by is built from the whitelisted sort keys.
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE deleted_at IS NULL AND updated_at >= $1 ORDER BY ` + store.OrderBy(f, "position")
 
-	return t.fetch(ctx, query)
+	return t.fetch(ctx, query, f.ModifiedSince)
 }
 
 func (t *taskRepository) FetchByColumnID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
-	query := `SELECT id, position, name, description, colum_id FROM tasks WHERE colum_id = $1 ORDER BY position`
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE colum_id = $1 AND deleted_at IS NULL ORDER BY position`
 
 	return t.fetch(ctx, query, id)
 }
 
+func (t *taskRepository) FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE colum_id = ANY($1::uuid[]) AND deleted_at IS NULL ORDER BY colum_id, position`
+
+	return t.fetch(ctx, query, store.IDs(ids))
+}
+
 func (t *taskRepository) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
-	query := `SELECT id, position, name, description, colum_id FROM tasks WHERE colum_id IN 
-	(SELECT id FROM columns WHERE project_id = $1)`
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE colum_id IN (SELECT id FROM columns WHERE project_id = $1) AND deleted_at IS NULL`
+
+	return t.fetch(ctx, query, id)
+}
+
+// FetchDeletedByProjectID returns the tasks deleted one by one from the live columns of the project.
+func (t *taskRepository) FetchDeletedByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE colum_id IN (SELECT id FROM columns WHERE project_id = $1 AND deleted_at IS NULL)
+	AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+
+	return t.fetch(ctx, query, id)
+}
+
+func (t *taskRepository) FetchByParentID(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL ORDER BY position`
 
 	return t.fetch(ctx, query, id)
 }
@@ -76,6 +109,11 @@ func (t *taskRepository) getOne(ctx context.Context, query string, args ...inter
 		&res.Name,
 		&res.Description,
 		&res.ColumnID,
+		&res.ParentID,
+		&res.CompletedAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+		&res.DeletedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Task{}, fmt.Errorf("task: %w", domain.ErrNotFound)
@@ -88,7 +126,15 @@ func (t *taskRepository) getOne(ctx context.Context, query string, args ...inter
 }
 
 func (t *taskRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Task, error) {
-	query := `SELECT id, position, name, description, colum_id FROM tasks WHERE id = $1`
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE id = $1 AND deleted_at IS NULL`
+
+	return t.getOne(ctx, query, id)
+}
+
+func (t *taskRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+	query := `SELECT id, position, name, description, colum_id, parent_id, completed_at, created_at, updated_at, deleted_at
+	FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL`
 
 	return t.getOne(ctx, query, id)
 }
@@ -98,7 +144,8 @@ func (t *taskRepository) Update(ctx context.Context, tks ...domain.Task) error {
 	if err != nil {
 		return fmt.Errorf("tx begin: %w", err)
 	}
-	query := `UPDATE tasks SET position=$2, name=$3, description=$4, colum_id=$5 WHERE id = $1`
+	query := `UPDATE tasks SET position=$2, name=$3, description=$4, colum_id=$5, parent_id=$6, completed_at=$7
+	WHERE id = $1`
 	stmt, err := txn.Prepare(query)
 	if err != nil {
 		return fmt.Errorf("tx prepare: %w", err)
@@ -106,7 +153,8 @@ func (t *taskRepository) Update(ctx context.Context, tks ...domain.Task) error {
 	defer stmt.Close()
 
 	for _, tk := range tks {
-		_, err = t.db.ExecContext(ctx, query, tk.ID, tk.Position, tk.Name, tk.Description, tk.ColumnID)
+		_, err = t.db.ExecContext(ctx, query,
+			tk.ID, tk.Position, tk.Name, tk.Description, tk.ColumnID, tk.ParentID, tk.CompletedAt)
 		if err != nil {
 			return fmt.Errorf("smt exec: %w", err)
 		}
@@ -121,10 +169,19 @@ func (t *taskRepository) Update(ctx context.Context, tks ...domain.Task) error {
 	return nil
 }
 
-func (t *taskRepository) Store(ctx context.Context, ts *domain.Task) error {
-	query := `INSERT INTO tasks (position, name, description, colum_id) VALUES ( $1, $2, $3, $4) RETURNING id`
-	row := t.db.QueryRowContext(ctx, query, ts.Position, ts.Name, ts.Description, ts.ColumnID)
-	err := row.Scan(&ts.ID)
+// Store inserts the task and writes its records in the same transaction.
+func (t *taskRepository) Store(ctx context.Context, ts *domain.Task, rec domain.TaskRecords) error {
+	err := store.WithTx(ctx, t.db, func(tx *sql.Tx) error {
+		query := `INSERT INTO tasks (position, name, description, colum_id, parent_id, completed_at)
+		VALUES ( $1, $2, $3, $4, $5, $6) RETURNING id, created_at, updated_at`
+		row := tx.QueryRowContext(ctx, query,
+			ts.Position, ts.Name, ts.Description, ts.ColumnID, ts.ParentID, ts.CompletedAt)
+		if err := row.Scan(&ts.ID, &ts.CreatedAt, &ts.UpdatedAt); err != nil {
+			return fmt.Errorf("insert task: %w", err)
+		}
+
+		return storeRecords(ctx, tx, ts.ID, rec)
+	})
 	if err != nil {
 		return fmt.Errorf("store error: %w", err)
 	}
@@ -132,12 +189,123 @@ func (t *taskRepository) Store(ctx context.Context, ts *domain.Task) error {
 	return nil
 }
 
+// Move updates the moved tasks and writes the records of the move in the same transaction.
+func (t *taskRepository) Move(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+	err := store.WithTx(ctx, t.db, func(tx *sql.Tx) error {
+		query := `UPDATE tasks SET position=$2, name=$3, description=$4, colum_id=$5, parent_id=$6, completed_at=$7
+		WHERE id = $1`
+		for _, tk := range tks {
+			_, err := tx.ExecContext(ctx, query,
+				tk.ID, tk.Position, tk.Name, tk.Description, tk.ColumnID, tk.ParentID, tk.CompletedAt)
+			if err != nil {
+				return fmt.Errorf("update task: %w", err)
+			}
+		}
+
+		for _, rec := range recs {
+			if err := storeRecords(ctx, tx, uuid.Nil, rec); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("move error: %w", err)
+	}
+
+	return nil
+}
+
+// storeRecords inserts the records of a task change, the records without a task id get taskID.
+func storeRecords(ctx context.Context, tx *sql.Tx, taskID uuid.UUID, rec domain.TaskRecords) error {
+	if tr := rec.Transition; tr != nil {
+		if tr.TaskID == uuid.Nil {
+			tr.TaskID = taskID
+		}
+		query := `INSERT INTO task_transitions (task_id, from_column_id, to_column_id, created_at)
+		VALUES ($1, $2, $3, $4) RETURNING id`
+		err := tx.QueryRowContext(ctx, query, tr.TaskID, tr.FromColumnID, tr.ToColumnID, tr.CreatedAt).Scan(&tr.ID)
+		if err != nil {
+			return fmt.Errorf("insert transition: %w", err)
+		}
+	}
+	if o := rec.WIPOverride; o != nil {
+		if o.TaskID == uuid.Nil {
+			o.TaskID = taskID
+		}
+		query := `INSERT INTO wip_overrides (task_id, column_id, wip_limit, count, created_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`
+		err := tx.QueryRowContext(ctx, query, o.TaskID, o.ColumnID, o.WIPLimit, o.Count, o.CreatedAt).Scan(&o.ID)
+		if err != nil {
+			return fmt.Errorf("insert wip override: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete marks the task with its comments deleted at the same time.
 func (t *taskRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM tasks WHERE id = $1`
-	_, err := t.db.ExecContext(ctx, query, id)
+	queries := []string{
+		`UPDATE tasks SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+		`UPDATE comments SET deleted_at = NOW() WHERE task_id = $1 AND deleted_at IS NULL`,
+	}
+	err := store.WithTx(ctx, t.db, func(tx *sql.Tx) error {
+		for _, query := range queries {
+			if _, err := tx.ExecContext(ctx, query, id); err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("delete error: %w", err)
 	}
 
 	return nil
 }
+
+// Restore brings the task back into its column at its position with the comments deleted together with it,
+// the records of the restore are written in the same transaction.
+func (t *taskRepository) Restore(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+	err := store.WithTx(ctx, t.db, func(tx *sql.Tx) error {
+		query := `UPDATE tasks SET deleted_at = NULL, colum_id = $2, position = $3, completed_at = $4
+		WHERE id = $1 AND deleted_at = $5 RETURNING updated_at`
+		err := tx.QueryRowContext(ctx, query, tk.ID, tk.ColumnID, tk.Position, tk.CompletedAt, tk.DeletedAt).
+			Scan(&tk.UpdatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("task: %w", domain.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("update task: %w", err)
+		}
+		query = `UPDATE comments SET deleted_at = NULL WHERE task_id = $1 AND deleted_at = $2`
+		if _, err = tx.ExecContext(ctx, query, tk.ID, tk.DeletedAt); err != nil {
+			return fmt.Errorf("update comments: %w", err)
+		}
+
+		return storeRecords(ctx, tx, tk.ID, rec)
+	})
+	if err != nil {
+		return fmt.Errorf("restore error: %w", err)
+	}
+	tk.DeletedAt = nil
+
+	return nil
+}
+
+func (t *taskRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM tasks WHERE deleted_at < $1`
+	res, err := t.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge error: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return n, nil
+}
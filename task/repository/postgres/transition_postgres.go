@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+type transitionRepository struct {
+	db *sql.DB
+}
+
+// NewTransition will create new a TransitionRepository object representation
+// of domain.TransitionRepository interface.
+func NewTransition(db *sql.DB) domain.TransitionRepository {
+	return &transitionRepository{db: db}
+}
+
+func (t *transitionRepository) fetch(ctx context.Context, query string, args ...interface{}) (
+	[]domain.Transition, error,
+) {
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+	result := make([]domain.Transition, 0)
+	for rows.Next() {
+		tr := domain.Transition{}
+		err = rows.Scan(&tr.ID, &tr.TaskID, &tr.FromColumnID, &tr.ToColumnID, &tr.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("rows scan error: %w", err)
+		}
+		result = append(result, tr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("encountered during iteration %w", err)
+	}
+
+	return result, nil
+}
+
+func (t *transitionRepository) FetchByProjectID(ctx context.Context, id uuid.UUID) ([]domain.Transition, error) {
+	query := `SELECT id, task_id, from_column_id, to_column_id, created_at FROM task_transitions
+	WHERE task_id IN (SELECT id FROM tasks WHERE deleted_at IS NULL
+	AND colum_id IN (SELECT id FROM columns WHERE project_id = $1))
+	ORDER BY created_at`
+
+	return t.fetch(ctx, query, id)
+}
+
+// FetchRecentByProjectID returns the last limit transitions of the live tasks of the project, the newest first.
+func (t *transitionRepository) FetchRecentByProjectID(
+	ctx context.Context, id uuid.UUID, limit int,
+) ([]domain.Transition, error) {
+	query := `SELECT id, task_id, from_column_id, to_column_id, created_at FROM task_transitions
+	WHERE task_id IN (SELECT id FROM tasks WHERE deleted_at IS NULL
+	AND colum_id IN (SELECT id FROM columns WHERE project_id = $1 AND deleted_at IS NULL))
+	ORDER BY created_at DESC LIMIT $2`
+
+	return t.fetch(ctx, query, id, limit)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -14,15 +15,50 @@ type taskUsecase struct {
 	columnRepo  domain.ColumnRepository
 	taskRepo    domain.TaskRepository
 	commentRepo domain.CommentRepository
+	linkRepo    domain.LinkRepository
+	projectRepo domain.ProjectRepository
+	blockDone   bool
+}
+
+// Option configures optional rules of the TaskUsecase.
+type Option func(*taskUsecase)
+
+// WithBlockedDone refuses to move a task into a done column while its blockers are open.
+func WithBlockedDone() Option {
+	return func(t *taskUsecase) {
+		t.blockDone = true
+	}
 }
 
 // New will create new a TaskUsecase object representation of domain.TaskUsecase interface.
-func New(cl domain.ColumnRepository, t domain.TaskRepository, c domain.CommentRepository) domain.TaskUsecase {
-	return &taskUsecase{columnRepo: cl, taskRepo: t, commentRepo: c}
+func New(
+	cl domain.ColumnRepository,
+	t domain.TaskRepository,
+	c domain.CommentRepository,
+	l domain.LinkRepository,
+	p domain.ProjectRepository,
+	opts ...Option,
+) domain.TaskUsecase {
+	tu := &taskUsecase{
+		columnRepo:  cl,
+		taskRepo:    t,
+		commentRepo: c,
+		linkRepo:    l,
+		projectRepo: p,
+	}
+	for _, opt := range opts {
+		opt(tu)
+	}
+
+	return tu
+}
+
+func (t *taskUsecase) Fetch(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
+	return t.taskRepo.Fetch(ctx, f)
 }
 
-func (t *taskUsecase) Fetch(ctx context.Context) ([]domain.Task, error) {
-	return t.taskRepo.Fetch(ctx)
+func (t *taskUsecase) FetchByColumnIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Task, error) {
+	return t.taskRepo.FetchByColumnIDs(ctx, ids)
 }
 
 func (t *taskUsecase) FetchComments(ctx context.Context, id uuid.UUID) ([]domain.Comment, error) {
@@ -33,10 +69,46 @@ func (t *taskUsecase) FetchComments(ctx context.Context, id uuid.UUID) ([]domain
 	return t.commentRepo.FetchByTaskID(ctx, id)
 }
 
+func (t *taskUsecase) FetchChildren(ctx context.Context, id uuid.UUID) (domain.TaskChildren, error) {
+	parent, err := t.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.TaskChildren{}, fmt.Errorf("get parent task by id: %w", err)
+	}
+	column, err := t.columnRepo.GetByID(ctx, parent.ColumnID)
+	if err != nil {
+		return domain.TaskChildren{}, fmt.Errorf("get column by id: %w", err)
+	}
+	columns, err := t.columnRepo.FetchByProjectID(ctx, column.ProjectID)
+	if err != nil {
+		return domain.TaskChildren{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	children, err := t.taskRepo.FetchByParentID(ctx, id)
+	if err != nil {
+		return domain.TaskChildren{}, fmt.Errorf("fetch tasks by parent id: %w", err)
+	}
+	byID := make(map[uuid.UUID]domain.Column, len(columns))
+	for _, c := range columns {
+		byID[c.ID] = c
+	}
+	progress := domain.Progress{Total: len(children), ByStatus: make(map[string]int)}
+	for _, c := range children {
+		column := byID[c.ColumnID]
+		progress.ByStatus[column.Status]++
+		if column.Category == domain.CategoryDone {
+			progress.Done++
+		}
+	}
+
+	return domain.TaskChildren{Children: children, Progress: progress}, nil
+}
+
 func (t *taskUsecase) StoreComment(ctx context.Context, cm *domain.Comment) error {
 	if _, err := t.taskRepo.GetByID(ctx, cm.TaskID); err != nil {
 		return fmt.Errorf("get comments by task id: %w", err)
 	}
+	if err := domain.CheckArchived(t.projectRepo.GetByTaskID(ctx, cm.TaskID)); err != nil {
+		return err
+	}
 	cm.CreatedAt = time.Now().UTC()
 
 	return t.commentRepo.Store(ctx, cm)
@@ -51,9 +123,24 @@ func (t *taskUsecase) Update(ctx context.Context, ts *domain.Task) error {
 	if err != nil {
 		return fmt.Errorf("fetch task by id: %w", err)
 	}
+	if err = domain.CheckArchived(t.projectRepo.GetByTaskID(ctx, ts.ID)); err != nil {
+		return err
+	}
+	ts.CompletedAt = old.CompletedAt
+	ts.CreatedAt = old.CreatedAt
+	ts.UpdatedAt = old.UpdatedAt
 	if reflect.DeepEqual(old, *ts) {
 		return nil
 	}
+	if ts.ParentID != nil && isParentChanged(&old, ts) {
+		column, err := t.columnRepo.GetByID(ctx, ts.ColumnID)
+		if err != nil {
+			return fmt.Errorf("get column by id: %w", err)
+		}
+		if err = t.checkParent(ctx, ts, column.ProjectID); err != nil {
+			return err
+		}
+	}
 	if ts.ColumnID != old.ColumnID {
 		return t.ChangeColumn(ctx, &old, ts)
 	}
@@ -79,10 +166,24 @@ func (t *taskUsecase) Update(ctx context.Context, ts *domain.Task) error {
 }
 
 func (t *taskUsecase) ChangeColumn(ctx context.Context, old, tk *domain.Task) error {
-	_, err := t.columnRepo.GetByID(ctx, tk.ColumnID)
+	column, err := t.columnRepo.GetByID(ctx, tk.ColumnID)
 	if err != nil {
 		return fmt.Errorf("get column by id: %w", err)
 	}
+	// The task may move into the column of another project.
+	if err = domain.CheckArchived(t.projectRepo.GetByID(ctx, column.ProjectID)); err != nil {
+		return err
+	}
+	if err = t.checkChildren(ctx, old, &column); err != nil {
+		return err
+	}
+	if t.blockDone && column.Category == domain.CategoryDone {
+		if err = t.checkBlockers(ctx, tk.ID); err != nil {
+			return err
+		}
+	}
+	tk.CompletedAt = old.CompletedAt
+	tk.SetCompletedAt(&column)
 	oldTasks, err := t.taskRepo.FetchByColumnID(ctx, old.ColumnID)
 	if err != nil {
 		return fmt.Errorf("fetch old task by column id: %w", err)
@@ -91,6 +192,11 @@ func (t *taskUsecase) ChangeColumn(ctx context.Context, old, tk *domain.Task) er
 	if err != nil {
 		return fmt.Errorf("fetch task by column id: %w", err)
 	}
+	overridden, err := domain.CheckWIPLimit(ctx, &column, len(tasks))
+	if err != nil {
+		return err
+	}
+	count := len(tasks) + 1
 	if tk.Position >= len(tasks) {
 		tk.Position = len(tasks)
 	}
@@ -106,8 +212,126 @@ func (t *taskUsecase) ChangeColumn(ctx context.Context, old, tk *domain.Task) er
 	}
 	tasks = append(tasks, oldTasks...)
 	tasks = append(tasks, *tk)
+	rec := domain.NewTaskRecords(tk.ID, &old.ColumnID, &column, count, overridden)
+	if err = t.taskRepo.Move(ctx, []domain.TaskRecords{rec}, tasks...); err != nil {
+		return fmt.Errorf("move tasks: %w", err)
+	}
+
+	return nil
+}
+
+func (t *taskUsecase) FetchLinks(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+	if _, err := t.taskRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("get links by task id: %w", err)
+	}
+
+	return t.linkRepo.FetchByTaskID(ctx, id)
+}
+
+func (t *taskUsecase) StoreLink(ctx context.Context, l *domain.Link) error {
+	if l.TaskID == l.LinkedTaskID {
+		return fmt.Errorf("link to itself: %w", domain.ErrBadParamInput)
+	}
+	if _, err := t.taskRepo.GetByID(ctx, l.TaskID); err != nil {
+		return fmt.Errorf("get task by id: %w", err)
+	}
+	if err := domain.CheckArchived(t.projectRepo.GetByTaskID(ctx, l.TaskID)); err != nil {
+		return err
+	}
+	if _, err := t.taskRepo.GetByID(ctx, l.LinkedTaskID); err != nil {
+		return fmt.Errorf("get linked task by id: %w", err)
+	}
+	links, err := t.linkRepo.FetchByTaskID(ctx, l.TaskID)
+	if err != nil {
+		return fmt.Errorf("fetch links by task id: %w", err)
+	}
+	for _, lk := range links {
+		if lk.Type == l.Type && lk.LinkedTaskID == l.LinkedTaskID {
+			return fmt.Errorf("link: %w", domain.ErrConflict)
+		}
+	}
+	link := *l
+	if link.IsInverse() {
+		link = link.Inverse()
+	}
+	if link.Type == domain.LinkBlocks {
+		if err = t.checkBlocksCycle(ctx, link.TaskID, link.LinkedTaskID); err != nil {
+			return err
+		}
+	}
+	if err = t.linkRepo.Store(ctx, &link); err != nil {
+		return fmt.Errorf("store link: %w", err)
+	}
+	l.ID = link.ID
+
+	return nil
+}
+
+func (t *taskUsecase) DeleteLink(ctx context.Context, taskID, id uuid.UUID) error {
+	link, err := t.linkRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get link by id: %w", err)
+	}
+	if link.TaskID != taskID && link.LinkedTaskID != taskID {
+		return fmt.Errorf("task link: %w", domain.ErrNotFound)
+	}
+	if err = domain.CheckArchived(t.projectRepo.GetByTaskID(ctx, taskID)); err != nil {
+		return err
+	}
+
+	return t.linkRepo.Delete(ctx, id)
+}
+
+// checkBlocksCycle verifies that the blocked task does not already block the blocker, directly or transitively.
+func (t *taskUsecase) checkBlocksCycle(ctx context.Context, blocker, blocked uuid.UUID) error {
+	visited := map[uuid.UUID]bool{blocked: true}
+	queue := []uuid.UUID{blocked}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		links, err := t.linkRepo.FetchByTaskID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("fetch links by task id: %w", err)
+		}
+		for _, l := range links {
+			if l.Type != domain.LinkBlocks || visited[l.LinkedTaskID] {
+				continue
+			}
+			if l.LinkedTaskID == blocker {
+				return domain.ErrLinkCycle
+			}
+			visited[l.LinkedTaskID] = true
+			queue = append(queue, l.LinkedTaskID)
+		}
+	}
+
+	return nil
+}
+
+// checkBlockers verifies that every task blocking the task sits in a done column.
+func (t *taskUsecase) checkBlockers(ctx context.Context, id uuid.UUID) error {
+	links, err := t.linkRepo.FetchByTaskID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("fetch links by task id: %w", err)
+	}
+	for _, l := range links {
+		if l.Type != domain.LinkBlockedBy {
+			continue
+		}
+		blocker, err := t.taskRepo.GetByID(ctx, l.LinkedTaskID)
+		if err != nil {
+			return fmt.Errorf("get blocker by id: %w", err)
+		}
+		column, err := t.columnRepo.GetByID(ctx, blocker.ColumnID)
+		if err != nil {
+			return fmt.Errorf("get blocker column by id: %w", err)
+		}
+		if column.Category != domain.CategoryDone {
+			return domain.ErrBlocked
+		}
+	}
 
-	return t.taskRepo.Update(ctx, tasks...)
+	return nil
 }
 
 func (t *taskUsecase) MoveRight(ctx context.Context, old, tk *domain.Task, tks []domain.Task) error {
@@ -131,35 +355,167 @@ func (t *taskUsecase) MoveLeft(ctx context.Context, old, tk *domain.Task, tks []
 }
 
 func (t *taskUsecase) Store(ctx context.Context, tk *domain.Task) error {
-	_, err := t.columnRepo.GetByID(ctx, tk.ColumnID)
+	column, err := t.columnRepo.GetByID(ctx, tk.ColumnID)
 	if err != nil {
 		return fmt.Errorf("column get by id: %w", err)
 	}
+	if err = domain.CheckArchived(t.projectRepo.GetByID(ctx, column.ProjectID)); err != nil {
+		return err
+	}
+	if tk.ParentID != nil {
+		if err = t.checkParent(ctx, tk, column.ProjectID); err != nil {
+			return err
+		}
+	}
+	tk.CompletedAt = nil
+	tk.SetCompletedAt(&column)
 	tasks, err := t.taskRepo.FetchByColumnID(ctx, tk.ColumnID)
 	if err != nil {
 		return fmt.Errorf("fetch by project id: %w", err)
 	}
+	overridden, err := domain.CheckWIPLimit(ctx, &column, len(tasks))
+	if err != nil {
+		return err
+	}
 	if tk.Position >= len(tasks) {
 		tk.Position = len(tasks)
-
-		return t.taskRepo.Store(ctx, tk)
-	}
-
-	ut := tasks[tk.Position:]
-	for i := range ut {
-		ut[i].Position++
+	} else {
+		ut := tasks[tk.Position:]
+		for i := range ut {
+			ut[i].Position++
+		}
+		if err = t.taskRepo.Update(ctx, ut...); err != nil {
+			return fmt.Errorf("update positions: %w", err)
+		}
 	}
-	if err = t.taskRepo.Update(ctx, ut...); err != nil {
-		return fmt.Errorf("update positions: %w", err)
+	rec := domain.NewTaskRecords(uuid.Nil, nil, &column, len(tasks)+1, overridden)
+	if err = t.taskRepo.Store(ctx, tk, rec); err != nil {
+		return fmt.Errorf("store task: %w", err)
 	}
 
-	return t.taskRepo.Store(ctx, tk)
+	return nil
 }
 
 func (t *taskUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 	if _, err := t.taskRepo.GetByID(ctx, id); err != nil {
 		return fmt.Errorf("delete task: %w", err)
 	}
+	if err := domain.CheckArchived(t.projectRepo.GetByTaskID(ctx, id)); err != nil {
+		return err
+	}
 
 	return t.taskRepo.Delete(ctx, id)
 }
+
+// Restore brings the task back as the last one of its column,
+// or of the first column of the project when its column is deleted too.
+func (t *taskUsecase) Restore(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+	tk, err := t.taskRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("get deleted task by id: %w", err)
+	}
+	column, err := t.columnRepo.GetByID(ctx, tk.ColumnID)
+	if errors.Is(err, domain.ErrNotFound) {
+		column, err = t.fallbackColumn(ctx, tk.ColumnID)
+	}
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if err = domain.CheckArchived(t.projectRepo.GetByID(ctx, column.ProjectID)); err != nil {
+		return domain.Task{}, err
+	}
+	tasks, err := t.taskRepo.FetchByColumnID(ctx, column.ID)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("fetch tasks by column id: %w", err)
+	}
+	overridden, err := domain.CheckWIPLimit(ctx, &column, len(tasks))
+	if err != nil {
+		return domain.Task{}, err
+	}
+	from := tk.ColumnID
+	tk.ColumnID = column.ID
+	tk.Position = len(tasks)
+	tk.SetCompletedAt(&column)
+	rec := domain.NewTaskRecords(tk.ID, &from, &column, len(tasks)+1, overridden)
+	if from == column.ID {
+		rec.Transition = nil
+	}
+	if err = t.taskRepo.Restore(ctx, &tk, rec); err != nil {
+		return domain.Task{}, fmt.Errorf("restore task: %w", err)
+	}
+
+	return tk, nil
+}
+
+// fallbackColumn returns the first live column of the project the deleted column belonged to.
+func (t *taskUsecase) fallbackColumn(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+	deleted, err := t.columnRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("get deleted column by id: %w", err)
+	}
+	columns, err := t.columnRepo.FetchByProjectID(ctx, deleted.ProjectID)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("fetch columns by project id: %w", err)
+	}
+	if len(columns) == 0 {
+		return domain.Column{}, fmt.Errorf("project of the task is deleted: %w", domain.ErrConflict)
+	}
+
+	return columns[0], nil
+}
+
+// checkChildren refuses to move the task into the column of another project while it has children,
+// they would be left in the old project with a parent in the new one.
+func (t *taskUsecase) checkChildren(ctx context.Context, old *domain.Task, column *domain.Column) error {
+	oldColumn, err := t.columnRepo.GetByID(ctx, old.ColumnID)
+	if err != nil {
+		return fmt.Errorf("get old column by id: %w", err)
+	}
+	if oldColumn.ProjectID == column.ProjectID {
+		return nil
+	}
+	children, err := t.taskRepo.FetchByParentID(ctx, old.ID)
+	if err != nil {
+		return fmt.Errorf("fetch tasks by parent id: %w", err)
+	}
+	if len(children) > 0 {
+		return fmt.Errorf("task has %d children: %w", len(children), domain.ErrParentProject)
+	}
+
+	return nil
+}
+
+// checkParent verifies that the parent of tk belongs to the project and is not tk or one of its descendants.
+func (t *taskUsecase) checkParent(ctx context.Context, tk *domain.Task, projectID uuid.UUID) error {
+	parent, err := t.taskRepo.GetByID(ctx, *tk.ParentID)
+	if err != nil {
+		return fmt.Errorf("get parent task by id: %w", err)
+	}
+	column, err := t.columnRepo.GetByID(ctx, parent.ColumnID)
+	if err != nil {
+		return fmt.Errorf("get parent column by id: %w", err)
+	}
+	if column.ProjectID != projectID {
+		return domain.ErrParentProject
+	}
+	for {
+		if parent.ID == tk.ID {
+			return domain.ErrParentCycle
+		}
+		if parent.ParentID == nil {
+			return nil
+		}
+		if parent, err = t.taskRepo.GetByID(ctx, *parent.ParentID); err != nil {
+			return fmt.Errorf("get ancestor task by id: %w", err)
+		}
+	}
+}
+
+// isParentChanged reports whether tk got a new parent or moved to another column.
+func isParentChanged(old, tk *domain.Task) bool {
+	if old.ParentID == nil || *old.ParentID != *tk.ParentID {
+		return true
+	}
+
+	return old.ColumnID != tk.ColumnID
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/igkostyuk/tasktracker/domain"
@@ -20,12 +21,13 @@ func TestFetch(t *testing.T) {
 	want := []domain.Task{{Name: "test"}}
 	// nolint:exhaustivestruct
 	mt := &mocks.TaskRepositoryMock{
-		FetchFunc: func(ctx context.Context) ([]domain.Task, error) {
+		FetchFunc: func(ctx context.Context, f domain.Filter) ([]domain.Task, error) {
 			return want, nil
 		},
 	}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
-	projects, err := u.Fetch(context.TODO())
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
+	projects, err := u.Fetch(context.TODO(), domain.Filter{})
 	is.NoErr(err)
 	is.Equal(want, projects)
 	is.Equal(len(mt.FetchCalls()), 1)
@@ -48,7 +50,8 @@ func TestFetchComments(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc)
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc,
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	columns, err := u.FetchComments(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, columns)
@@ -76,7 +79,8 @@ func TestFetchTaskError(t *testing.T) {
 			return nil, nil
 		},
 	}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc)
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc,
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	_, err := u.FetchComments(context.TODO(), id)
 	is.True(err != nil)
 
@@ -103,7 +107,8 @@ func TestStoreComment(t *testing.T) {
 	}
 	// nolint:exhaustivestruct
 	comment := domain.Comment{TaskID: uuid.New(), Text: "test"}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc)
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc,
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	err := u.StoreComment(context.TODO(), &comment)
 	is.NoErr(err)
 
@@ -130,7 +135,8 @@ func TestStoreCommentError(t *testing.T) {
 	}
 	// nolint:exhaustivestruct
 	comment := domain.Comment{TaskID: uuid.New(), Text: "test"}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc)
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, mc,
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	err := u.StoreComment(context.TODO(), &comment)
 	is.True(err != nil)
 
@@ -153,7 +159,8 @@ func TestGetByID(t *testing.T) {
 			return want, nil
 		},
 	}
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	project, err := u.GetByID(context.TODO(), id)
 	is.NoErr(err)
 	is.Equal(want, project)
@@ -214,7 +221,8 @@ func TestMoveRight(t *testing.T) {
 			}
 			// nolint:exhaustivestruct
 			tk := domain.Task{Name: "test", Position: tc.to}
-			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.MoveRight(context.TODO(), &tasks[tc.from], &tk, tasks)
 			is.NoErr(err)
 			cu := mt.UpdateCalls()
@@ -275,7 +283,8 @@ func TestMoveLeft(t *testing.T) {
 				},
 			}
 			tk := domain.Task{Name: "test", Position: tc.to}
-			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.MoveLeft(context.TODO(), &tasks[tc.from], &tk, tasks)
 			is.NoErr(err)
 			cu := mt.UpdateCalls()
@@ -292,7 +301,7 @@ func TestChangeColumn(t *testing.T) {
 	// nolint:exhaustivestruct
 	mc := &mocks.ColumnRepositoryMock{
 		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
-			return domain.Column{}, nil
+			return domain.Column{ID: id}, nil
 		},
 	}
 	// nolint:exhaustivestruct
@@ -313,7 +322,7 @@ func TestChangeColumn(t *testing.T) {
 				{Name: "3", Position: 3, ColumnID: secondID},
 			}, nil
 		},
-		UpdateFunc: func(ctx context.Context, cls ...domain.Task) error {
+		MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, cls ...domain.Task) error {
 			return nil
 		},
 	}
@@ -327,18 +336,80 @@ func TestChangeColumn(t *testing.T) {
 	otk := domain.Task{Name: "test", Position: 1, ColumnID: firstID}
 	// nolint:exhaustivestruct
 	tk := domain.Task{Name: "test", Position: 2, ColumnID: secondID}
-	u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{})
+	u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	err := u.ChangeColumn(context.TODO(), &otk, &tk)
 	is.NoErr(err)
 	cg := mc.GetByIDCalls()
 	cf := mt.FetchByColumnIDCalls()
-	cu := mt.UpdateCalls()
-	is.Equal(len(cg), 1)
+	cu := mt.MoveCalls()
+	is.Equal(len(cg), 2)
 	is.Equal(len(cf), 2)
 	is.Equal(cf[0].ID, firstID)
 	is.Equal(cf[1].ID, secondID)
 	is.Equal(len(cu), 1)
 	is.Equal(cu[0].Tks, want)
+	is.Equal(*cu[0].Recs[0].Transition.FromColumnID, firstID)
+	is.Equal(cu[0].Recs[0].Transition.ToColumnID, secondID)
+	is.True(cu[0].Recs[0].WIPOverride == nil)
+}
+
+//nolint:exhaustivestruct
+func TestChangeColumnOtherProject(t *testing.T) {
+	firstProject, secondProject := uuid.New(), uuid.New()
+	oldColumn, newColumn := uuid.New(), uuid.New()
+	tt := []struct {
+		name     string
+		children []domain.Task
+		want     error
+	}{
+		{"without children", nil, nil},
+		{"with children", []domain.Task{{ID: uuid.New(), ColumnID: oldColumn}}, domain.ErrParentProject},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					if id == oldColumn {
+						return domain.Column{ID: id, ProjectID: firstProject}, nil
+					}
+
+					return domain.Column{ID: id, ProjectID: secondProject}, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				FetchByParentIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					return tc.children, nil
+				},
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					if id == oldColumn {
+						return []domain.Task{{Name: "test", ColumnID: oldColumn}}, nil
+					}
+
+					return nil, nil
+				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			otk := domain.Task{ID: uuid.New(), Name: "test", ColumnID: oldColumn}
+			tk := otk
+			tk.ColumnID = newColumn
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{}, projectRepo())
+			err := u.ChangeColumn(context.TODO(), &otk, &tk)
+			is.Equal(mt.FetchByParentIDCalls()[0].ID, otk.ID)
+			if tc.want != nil {
+				is.True(errors.Is(err, tc.want))
+				is.Equal(len(mt.MoveCalls()), 0)
+
+				return
+			}
+			is.NoErr(err)
+			is.Equal(len(mt.MoveCalls()), 1)
+		})
+	}
 }
 
 func TestChangeColumnError(t *testing.T) {
@@ -381,7 +452,8 @@ func TestChangeColumnError(t *testing.T) {
 			otk := domain.Task{Name: "test", ColumnID: firstID}
 			// nolint:exhaustivestruct
 			tk := domain.Task{Name: "test", ColumnID: secondID}
-			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.ChangeColumn(context.TODO(), &otk, &tk)
 			is.True(err != nil)
 		})
@@ -438,8 +510,12 @@ func TestUpdate(t *testing.T) {
 				UpdateFunc: func(ctx context.Context, cls ...domain.Task) error {
 					return nil
 				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, cls ...domain.Task) error {
+					return nil
+				},
 			}
-			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.Update(context.TODO(), &tc.tk)
 			is.NoErr(err)
 			cg := mt.GetByIDCalls()
@@ -463,8 +539,10 @@ func TestUpdate(t *testing.T) {
 				is.Equal(len(cf), 2)
 				is.Equal(cf[0].ID, tc.old.ColumnID)
 				is.Equal(cf[1].ID, tc.tk.ColumnID)
-				is.Equal(len(cu), 1)
-				is.Equal(cu[0].Tks, []domain.Task{{Name: "2", Position: 1}, tc.tk})
+				is.Equal(len(cu), 0)
+				cm := mt.MoveCalls()
+				is.Equal(len(cm), 1)
+				is.Equal(cm[0].Tks, []domain.Task{{Name: "2", Position: 1}, tc.tk})
 
 				return
 			}
@@ -511,7 +589,8 @@ func TestUpdateError(t *testing.T) {
 			}
 			// nolint:exhaustivestruct
 			task := domain.Task{Name: tc.taskName, ID: uuid.New()}
-			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.Update(context.TODO(), &task)
 			is.True(err != nil)
 		})
@@ -547,11 +626,12 @@ func TestStore(t *testing.T) {
 				UpdateFunc: func(ctx context.Context, cls ...domain.Task) error {
 					return nil
 				},
-				StoreFunc: func(ctx context.Context, c *domain.Task) error {
+				StoreFunc: func(ctx context.Context, c *domain.Task, rec domain.TaskRecords) error {
 					return nil
 				},
 			}
-			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.Store(context.TODO(), &tc.tk)
 			is.NoErr(err)
 			cg := mc.GetByIDCalls()
@@ -622,11 +702,12 @@ func TestStoreErrors(t *testing.T) {
 				UpdateFunc: func(ctx context.Context, cls ...domain.Task) error {
 					return tc.updateError
 				},
-				StoreFunc: func(ctx context.Context, c *domain.Task) error {
+				StoreFunc: func(ctx context.Context, c *domain.Task, rec domain.TaskRecords) error {
 					return nil
 				},
 			}
-			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{})
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
 			err := u.Store(context.TODO(), &tc.tk)
 			is.True(err != nil)
 		})
@@ -647,7 +728,8 @@ func TestDelete(t *testing.T) {
 		},
 	}
 
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	err := u.Delete(context.TODO(), id)
 	is.NoErr(err)
 
@@ -673,7 +755,8 @@ func TestDeleteError(t *testing.T) {
 		},
 	}
 
-	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{})
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
 	err := u.Delete(context.TODO(), id)
 	is.True(err != nil)
 
@@ -683,3 +766,520 @@ func TestDeleteError(t *testing.T) {
 	cd := mt.DeleteCalls()
 	is.Equal(len(cd), 0)
 }
+
+//nolint:exhaustivestruct
+func TestFetchChildren(t *testing.T) {
+	is := helper.New(t)
+
+	id := uuid.New()
+	todoID := uuid.New()
+	doneID := uuid.New()
+	children := []domain.Task{
+		{Name: "0", ColumnID: todoID, ParentID: &id},
+		{Name: "1", ColumnID: doneID, ParentID: &id},
+		{Name: "2", ColumnID: doneID, ParentID: &id},
+	}
+	mc := &mocks.ColumnRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+			return domain.Column{ID: id}, nil
+		},
+		FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+			return []domain.Column{
+				{ID: todoID, Status: "todo", Category: domain.CategoryTodo},
+				{ID: doneID, Status: "done", Category: domain.CategoryDone},
+			}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+			return domain.Task{ID: id, ColumnID: todoID}, nil
+		},
+		FetchByParentIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+			return children, nil
+		},
+	}
+	u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+		&mocks.LinkRepositoryMock{}, projectRepo())
+	got, err := u.FetchChildren(context.TODO(), id)
+	is.NoErr(err)
+	is.Equal(got.Children, children)
+	is.Equal(got.Progress, domain.Progress{Total: 3, Done: 2, ByStatus: map[string]int{"todo": 1, "done": 2}})
+	cf := mt.FetchByParentIDCalls()
+	is.Equal(len(cf), 1)
+	is.Equal(cf[0].ID, id)
+}
+
+//nolint:exhaustivestruct,funlen
+func TestParentErrors(t *testing.T) {
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	columnID := uuid.New()
+	otherColumnID := uuid.New()
+	taskID := uuid.New()
+	childID := uuid.New()
+	parentID := uuid.New()
+	tasks := map[uuid.UUID]domain.Task{
+		taskID:   {ID: taskID, ColumnID: columnID},
+		childID:  {ID: childID, ColumnID: columnID, ParentID: &taskID},
+		parentID: {ID: parentID, ColumnID: otherColumnID},
+	}
+	tt := []struct {
+		name   string
+		store  bool
+		parent uuid.UUID
+		want   error
+	}{
+		{"store with parent from other project", true, parentID, domain.ErrParentProject},
+		{"update with parent from other project", false, parentID, domain.ErrParentProject},
+		{"update with itself as parent", false, taskID, domain.ErrParentCycle},
+		{"update with child as parent", false, childID, domain.ErrParentCycle},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					if id == otherColumnID {
+						return domain.Column{ID: id, ProjectID: otherProjectID}, nil
+					}
+
+					return domain.Column{ID: id, ProjectID: projectID}, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+					return tasks[id], nil
+				},
+				StoreFunc: func(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				&mocks.LinkRepositoryMock{}, projectRepo())
+			parent := tc.parent
+			tk := domain.Task{ID: taskID, ColumnID: columnID, ParentID: &parent}
+			var err error
+			if tc.store {
+				tk.ID = uuid.Nil
+				err = u.Store(context.TODO(), &tk)
+			} else {
+				err = u.Update(context.TODO(), &tk)
+			}
+			is.True(errors.Is(err, tc.want))
+			is.Equal(len(mt.StoreCalls()), 0)
+			is.Equal(len(mt.UpdateCalls()), 0)
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestStoreLink(t *testing.T) {
+	is := helper.New(t)
+
+	taskID := uuid.New()
+	linkedID := uuid.New()
+	mt := &mocks.TaskRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+			return domain.Task{ID: id}, nil
+		},
+	}
+	ml := &mocks.LinkRepositoryMock{
+		FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+			return []domain.Link{}, nil
+		},
+		StoreFunc: func(ctx context.Context, l *domain.Link) error {
+			l.ID = uuid.New()
+
+			return nil
+		},
+	}
+	link := domain.Link{Type: domain.LinkBlockedBy, TaskID: taskID, LinkedTaskID: linkedID}
+	u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+		ml, projectRepo())
+	err := u.StoreLink(context.TODO(), &link)
+	is.NoErr(err)
+	cs := ml.StoreCalls()
+	is.Equal(len(cs), 1)
+	is.Equal(*cs[0].L, domain.Link{ID: link.ID, Type: domain.LinkBlocks, TaskID: linkedID, LinkedTaskID: taskID})
+	is.Equal(link.Type, domain.LinkBlockedBy)
+}
+
+//nolint:exhaustivestruct
+func TestStoreLinkErrors(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+	third := uuid.New()
+	links := map[uuid.UUID][]domain.Link{
+		first:  {{Type: domain.LinkBlocks, TaskID: first, LinkedTaskID: second}},
+		second: {{Type: domain.LinkBlocks, TaskID: second, LinkedTaskID: third}},
+	}
+	tt := []struct {
+		name string
+		link domain.Link
+		want error
+	}{
+		{
+			"link to itself",
+			domain.Link{Type: domain.LinkRelatesTo, TaskID: first, LinkedTaskID: first},
+			domain.ErrBadParamInput,
+		},
+		{
+			"already exists",
+			domain.Link{Type: domain.LinkBlocks, TaskID: first, LinkedTaskID: second},
+			domain.ErrConflict,
+		},
+		{
+			"direct cycle",
+			domain.Link{Type: domain.LinkBlocks, TaskID: second, LinkedTaskID: first},
+			domain.ErrLinkCycle,
+		},
+		{
+			"transitive cycle",
+			domain.Link{Type: domain.LinkBlockedBy, TaskID: first, LinkedTaskID: third},
+			domain.ErrLinkCycle,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mt := &mocks.TaskRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+					return domain.Task{ID: id}, nil
+				},
+			}
+			ml := &mocks.LinkRepositoryMock{
+				FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+					return links[id], nil
+				},
+				StoreFunc: func(ctx context.Context, l *domain.Link) error {
+					return nil
+				},
+			}
+			u := taskUsecase.New(&mocks.ColumnRepositoryMock{}, mt, &mocks.CommentRepositoryMock{},
+				ml, projectRepo())
+			err := u.StoreLink(context.TODO(), &tc.link)
+			is.True(errors.Is(err, tc.want))
+			is.Equal(len(ml.StoreCalls()), 0)
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestChangeColumnBlocked(t *testing.T) {
+	doneID := uuid.New()
+	todoID := uuid.New()
+	blockerID := uuid.New()
+	tt := []struct {
+		name          string
+		blockerColumn uuid.UUID
+		want          error
+	}{
+		{"open blocker", todoID, domain.ErrBlocked},
+		{"done blocker", doneID, nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					if id == doneID {
+						return domain.Column{ID: id, Category: domain.CategoryDone}, nil
+					}
+
+					return domain.Column{ID: id, Category: domain.CategoryTodo}, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+					return domain.Task{ID: id, ColumnID: tc.blockerColumn}, nil
+				},
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					return []domain.Task{}, nil
+				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			ml := &mocks.LinkRepositoryMock{
+				FetchByTaskIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Link, error) {
+					return []domain.Link{{Type: domain.LinkBlockedBy, TaskID: id, LinkedTaskID: blockerID}}, nil
+				},
+			}
+			otk := domain.Task{ID: uuid.New(), ColumnID: todoID}
+			tk := domain.Task{ID: otk.ID, ColumnID: doneID}
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{},
+				ml, projectRepo(),
+				taskUsecase.WithBlockedDone())
+			err := u.ChangeColumn(context.TODO(), &otk, &tk)
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mt.MoveCalls()), 0)
+
+				return
+			}
+			cu := mt.MoveCalls()
+			is.Equal(len(cu), 1)
+			is.True(cu[0].Tks[len(cu[0].Tks)-1].CompletedAt != nil)
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestChangeColumnCompletedAt(t *testing.T) {
+	doneID := uuid.New()
+	todoID := uuid.New()
+	completedAt := time.Now().UTC().Add(-time.Hour)
+	tt := []struct {
+		name      string
+		completed *time.Time
+		to        uuid.UUID
+		want      func(*time.Time) bool
+	}{
+		{"enter done", nil, doneID, func(got *time.Time) bool { return got != nil }},
+		{"stay done", &completedAt, doneID, func(got *time.Time) bool { return got != nil && got.Equal(completedAt) }},
+		{"leave done", &completedAt, todoID, func(got *time.Time) bool { return got == nil }},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					if id == doneID {
+						return domain.Column{ID: id, Category: domain.CategoryDone}, nil
+					}
+
+					return domain.Column{ID: id, Category: domain.CategoryTodo}, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					return []domain.Task{}, nil
+				},
+				MoveFunc: func(ctx context.Context, recs []domain.TaskRecords, tks ...domain.Task) error {
+					return nil
+				},
+			}
+			otk := domain.Task{ID: uuid.New(), ColumnID: uuid.New(), CompletedAt: tc.completed}
+			tk := domain.Task{ID: otk.ID, ColumnID: tc.to}
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{},
+				projectRepo())
+			err := u.ChangeColumn(context.TODO(), &otk, &tk)
+			is.NoErr(err)
+			is.True(tc.want(tk.CompletedAt))
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestStoreWIPLimit(t *testing.T) {
+	limit := 2
+	tt := []struct {
+		name     string
+		override bool
+		want     error
+	}{
+		{"limit reached", false, domain.ErrWIPLimit},
+		{"limit overridden", true, nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					return domain.Column{ID: id, WIPLimit: &limit}, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					return []domain.Task{{Name: "0", Position: 0}, {Name: "1", Position: 1}}, nil
+				},
+				StoreFunc: func(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+					return nil
+				},
+			}
+			ctx := context.TODO()
+			if tc.override {
+				ctx = domain.WithWIPOverride(ctx)
+			}
+			tk := domain.Task{Name: "test", Position: 2, ColumnID: uuid.New()}
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{}, projectRepo())
+			err := u.Store(ctx, &tk)
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mt.StoreCalls()), 0)
+
+				return
+			}
+			cs := mt.StoreCalls()
+			is.Equal(len(cs), 1)
+			is.Equal(cs[0].Rec.Transition.FromColumnID, nil)
+			is.Equal(cs[0].Rec.Transition.ToColumnID, tk.ColumnID)
+			o := cs[0].Rec.WIPOverride
+			is.Equal(o.ColumnID, tk.ColumnID)
+			is.Equal(o.WIPLimit, limit)
+			is.Equal(o.Count, 3)
+		})
+	}
+}
+
+//nolint:exhaustivestruct,funlen
+func TestRestore(t *testing.T) {
+	projectID := uuid.New()
+	columnID := uuid.New()
+	firstID := uuid.New()
+	deletedAt := time.Now().UTC()
+	tt := []struct {
+		name       string
+		columns    []domain.Column
+		wantColumn uuid.UUID
+		want       error
+	}{
+		{"into its column", []domain.Column{{ID: firstID}, {ID: columnID}}, columnID, nil},
+		{"into first column", []domain.Column{{ID: firstID}}, firstID, nil},
+		{"deleted project", nil, uuid.Nil, domain.ErrConflict},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			live := make(map[uuid.UUID]domain.Column)
+			for _, c := range tc.columns {
+				c.ProjectID = projectID
+				live[c.ID] = c
+			}
+			mc := &mocks.ColumnRepositoryMock{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					if c, ok := live[id]; ok {
+						return c, nil
+					}
+
+					return domain.Column{}, fmt.Errorf("column: %w", domain.ErrNotFound)
+				},
+				GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+					return domain.Column{ID: id, ProjectID: projectID}, nil
+				},
+				FetchByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Column, error) {
+					return tc.columns, nil
+				},
+			}
+			mt := &mocks.TaskRepositoryMock{
+				GetDeletedByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+					return domain.Task{ID: id, ColumnID: columnID, Position: 5, DeletedAt: &deletedAt}, nil
+				},
+				FetchByColumnIDFunc: func(ctx context.Context, id uuid.UUID) ([]domain.Task, error) {
+					return []domain.Task{{Position: 0}, {Position: 1}}, nil
+				},
+				RestoreFunc: func(ctx context.Context, tk *domain.Task, rec domain.TaskRecords) error {
+					tk.DeletedAt = nil
+
+					return nil
+				},
+			}
+			u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{}, projectRepo())
+			got, err := u.Restore(context.TODO(), uuid.New())
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mt.RestoreCalls()), 0)
+
+				return
+			}
+			is.Equal(got.ColumnID, tc.wantColumn)
+			is.Equal(got.Position, 2)
+			is.True(got.DeletedAt == nil)
+			tr := mt.RestoreCalls()[0].Rec.Transition
+			if tc.wantColumn == columnID {
+				is.True(tr == nil)
+
+				return
+			}
+			is.Equal(*tr.FromColumnID, columnID)
+			is.Equal(tr.ToColumnID, firstID)
+		})
+	}
+}
+
+// nolint:exhaustivestruct
+func projectRepo() *mocks.ProjectRepositoryMock {
+	return &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id}, nil
+		},
+		GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: uuid.New()}, nil
+		},
+	}
+}
+
+//nolint:exhaustivestruct
+func TestArchivedProject(t *testing.T) {
+	is := helper.New(t)
+
+	archivedAt := time.Now().UTC()
+	id := uuid.New()
+	mc := &mocks.ColumnRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+			return domain.Column{ID: id}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+			return domain.Task{ID: id}, nil
+		},
+	}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: id, ArchivedAt: &archivedAt}, nil
+		},
+		GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ArchivedAt: &archivedAt}, nil
+		},
+	}
+	u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{}, mp)
+	is.True(errors.Is(u.Store(context.TODO(), &domain.Task{Name: "test"}), domain.ErrArchived))
+	is.True(errors.Is(u.Update(context.TODO(), &domain.Task{ID: id, Name: "test"}), domain.ErrArchived))
+	is.True(errors.Is(u.Delete(context.TODO(), id), domain.ErrArchived))
+	is.True(errors.Is(u.StoreComment(context.TODO(), &domain.Comment{TaskID: id}), domain.ErrArchived))
+	is.Equal(len(mt.StoreCalls()), 0)
+	is.Equal(len(mt.UpdateCalls()), 0)
+	is.Equal(len(mt.DeleteCalls()), 0)
+}
+
+//nolint:exhaustivestruct
+func TestChangeColumnArchivedTarget(t *testing.T) {
+	is := helper.New(t)
+
+	archivedAt := time.Now().UTC()
+	liveID, archivedID := uuid.New(), uuid.New()
+	old := domain.Task{ID: uuid.New(), Name: "test", ColumnID: uuid.New()}
+	mc := &mocks.ColumnRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Column, error) {
+			return domain.Column{ID: id, ProjectID: archivedID}, nil
+		},
+	}
+	mt := &mocks.TaskRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Task, error) {
+			return old, nil
+		},
+	}
+	mp := &mocks.ProjectRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			if id == archivedID {
+				return domain.Project{ID: id, ArchivedAt: &archivedAt}, nil
+			}
+
+			return domain.Project{ID: id}, nil
+		},
+		GetByTaskIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Project, error) {
+			return domain.Project{ID: liveID}, nil
+		},
+	}
+	u := taskUsecase.New(mc, mt, &mocks.CommentRepositoryMock{}, &mocks.LinkRepositoryMock{}, mp)
+
+	tk := old
+	tk.ColumnID = uuid.New()
+	is.True(errors.Is(u.Update(context.TODO(), &tk), domain.ErrArchived))
+	is.Equal(len(mt.MoveCalls()), 0)
+	is.Equal(mp.GetByIDCalls()[0].ID, archivedID)
+}
@@ -0,0 +1,192 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	"github.com/igkostyuk/tasktracker/internal/web"
+)
+
+type templateHandler struct {
+	templateUsecase domain.TemplateUsecase
+}
+
+// New return routes for template resource.
+func New(us domain.TemplateUsecase) chi.Router {
+	handler := &templateHandler{
+		templateUsecase: us,
+	}
+	r := chi.NewRouter()
+	r.Get("/", handler.Fetch)
+	r.Post("/", handler.Store)
+	r.Route("/{templateID}", func(r chi.Router) {
+		r.Get("/", handler.GetByID)
+		r.Put("/", handler.Update)
+		r.Delete("/", handler.Delete)
+	})
+
+	return r
+}
+
+// Fetch templates godoc
+// @Summary Get all board templates
+// @Description get all board templates
+// @Tags templates
+// @Produce  json
+// @Success 200 {array} domain.Template
+// @Failure 500 {object} web.Problem
+// @Router /templates [get]
+// Fetch will fetch templates.
+func (t *templateHandler) Fetch(w http.ResponseWriter, r *http.Request) {
+	templates, err := t.templateUsecase.Fetch(r.Context())
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, templates, http.StatusOK)
+}
+
+// GetByID godoc
+// @Summary Show a board template
+// @Description get board template by id
+// @Tags templates
+// @Produce  json
+// @Param  id path string true "template ID" format(uuid)
+// @Success 200 {object} domain.Template
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /templates/{id} [get]
+// GetByID will get template by given id.
+func (t *templateHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "templateID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	template, err := t.templateUsecase.GetByID(r.Context(), id)
+	if err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, template, http.StatusOK)
+}
+
+func isRequestValid(m *domain.Template) (bool, error) {
+	err := web.Validate(m)
+	if err != nil {
+		return false, fmt.Errorf("validation: %w", err)
+	}
+
+	return true, nil
+}
+
+// Store godoc
+// @Summary Add a board template
+// @Description add by json board template
+// @Tags templates
+// @Accept  json
+// @Produce  json
+// @Param template body domain.Template true "Add template"
+// @Success 200 {object} domain.Template
+// @Failure 400 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /templates [post]
+// Store will store the template by given request body.
+func (t *templateHandler) Store(w http.ResponseWriter, r *http.Request) {
+	var template domain.Template
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	if ok, err := isRequestValid(&template); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := t.templateUsecase.Store(r.Context(), &template); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, template, http.StatusOK)
+}
+
+// Update godoc
+// @Summary Update a board template
+// @Description update by json board template
+// @Tags templates
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "template ID" format(uuid)
+// @Param template body domain.Template true "Update template"
+// @Success 200 {object} domain.Template
+// @Failure 400 {object} web.Problem
+// @Failure 404 {object} web.Problem
+// @Failure 409 {object} web.Problem
+// @Failure 422 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /templates/{id} [put]
+// Update will update the template by given id and request body.
+func (t *templateHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "templateID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	var template domain.Template
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		web.RespondRequestError(w, r, err, http.StatusUnprocessableEntity)
+
+		return
+	}
+	template.ID = id
+	if ok, err := isRequestValid(&template); !ok {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	if err := t.templateUsecase.Update(r.Context(), &template); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+	web.Respond(w, r, template, http.StatusOK)
+}
+
+// Delete godoc
+// @Summary Delete a board template
+// @Description delete by template ID, the projects made from the template are kept
+// @Tags templates
+// @Produce  json
+// @Param  id path string true "template ID" format(uuid)
+// @Success 204 "it's ok"
+// @Failure 404 {object} web.Problem
+// @Failure 500 {object} web.Problem
+// @Router /templates/{id} [delete]
+// Delete will delete template by given param.
+func (t *templateHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "templateID"))
+	if err != nil {
+		web.RespondError(w, r, domain.ErrNotFound)
+
+		return
+	}
+	if err := t.templateUsecase.Delete(r.Context(), id); err != nil {
+		web.RespondError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
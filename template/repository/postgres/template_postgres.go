@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+type templateRepository struct {
+	db *sql.DB
+}
+
+// New will create new a templateRepository object representation of domain.TemplateRepository interface.
+func New(db *sql.DB) domain.TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+func (t *templateRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Template, error) {
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+	result := make([]domain.Template, 0)
+	for rows.Next() {
+		tm := domain.Template{}
+		var columns, labels []byte
+		err = rows.Scan(
+			&tm.ID,
+			&tm.Name,
+			&tm.Description,
+			&columns,
+			&labels,
+			&tm.CreatedAt,
+			&tm.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rows scan error: %w", err)
+		}
+		if err = json.Unmarshal(columns, &tm.Columns); err != nil {
+			return nil, fmt.Errorf("unmarshal columns: %w", err)
+		}
+		if err = json.Unmarshal(labels, &tm.Labels); err != nil {
+			return nil, fmt.Errorf("unmarshal labels: %w", err)
+		}
+		result = append(result, tm)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("encountered during iteration %w", err)
+	}
+
+	return result, nil
+}
+
+func (t *templateRepository) Fetch(ctx context.Context) ([]domain.Template, error) {
+	query := `SELECT id,name,description,columns,labels,created_at,updated_at FROM templates ORDER BY name`
+
+	return t.fetch(ctx, query)
+}
+
+func (t *templateRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	query := `SELECT id,name,description,columns,labels,created_at,updated_at FROM templates WHERE id = $1`
+	list, err := t.fetch(ctx, query, id)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	if len(list) == 0 {
+		return domain.Template{}, fmt.Errorf("template: %w", domain.ErrNotFound)
+	}
+
+	return list[0], nil
+}
+
+func (t *templateRepository) Update(ctx context.Context, tm *domain.Template) error {
+	columns, err := json.Marshal(tm.Columns)
+	if err != nil {
+		return fmt.Errorf("marshal columns: %w", err)
+	}
+	labels, err := json.Marshal(tm.Labels)
+	if err != nil {
+		return fmt.Errorf("marshal labels: %w", err)
+	}
+	query := `UPDATE templates SET name = $2,description = $3,columns = $4,labels = $5 WHERE id = $1
+	RETURNING created_at,updated_at`
+	row := t.db.QueryRowContext(ctx, query, tm.ID, tm.Name, tm.Description, columns, labels)
+	err = row.Scan(&tm.CreatedAt, &tm.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("template: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("update error: %w", err)
+	}
+
+	return nil
+}
+
+func (t *templateRepository) Store(ctx context.Context, tm *domain.Template) error {
+	columns, err := json.Marshal(tm.Columns)
+	if err != nil {
+		return fmt.Errorf("marshal columns: %w", err)
+	}
+	labels, err := json.Marshal(tm.Labels)
+	if err != nil {
+		return fmt.Errorf("marshal labels: %w", err)
+	}
+	query := `INSERT INTO templates (name,description,columns,labels) VALUES ($1, $2, $3, $4)
+	RETURNING id,created_at,updated_at`
+	row := t.db.QueryRowContext(ctx, query, tm.Name, tm.Description, columns, labels)
+	if err = row.Scan(&tm.ID, &tm.CreatedAt, &tm.UpdatedAt); err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+
+	return nil
+}
+
+func (t *templateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM templates WHERE id = $1`
+	_, err := t.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("delete error: %w", err)
+	}
+
+	return nil
+}
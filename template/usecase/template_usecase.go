@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+)
+
+type templateUsecase struct {
+	templateRepo domain.TemplateRepository
+}
+
+// New will create new a templateUsecase object representation of domain.TemplateUsecase interface.
+func New(t domain.TemplateRepository) domain.TemplateUsecase {
+	return &templateUsecase{templateRepo: t}
+}
+
+func (t *templateUsecase) Fetch(ctx context.Context) ([]domain.Template, error) {
+	return t.templateRepo.Fetch(ctx)
+}
+
+func (t *templateUsecase) GetByID(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	return t.templateRepo.GetByID(ctx, id)
+}
+
+func (t *templateUsecase) Update(ctx context.Context, tm *domain.Template) error {
+	if _, err := t.templateRepo.GetByID(ctx, tm.ID); err != nil {
+		return fmt.Errorf("update template: %w", err)
+	}
+	if err := prepareColumns(tm.Columns); err != nil {
+		return err
+	}
+	if err := prepareLabels(tm); err != nil {
+		return err
+	}
+
+	return t.templateRepo.Update(ctx, tm)
+}
+
+func (t *templateUsecase) Store(ctx context.Context, tm *domain.Template) error {
+	if err := prepareColumns(tm.Columns); err != nil {
+		return err
+	}
+	if err := prepareLabels(tm); err != nil {
+		return err
+	}
+
+	return t.templateRepo.Store(ctx, tm)
+}
+
+func (t *templateUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := t.templateRepo.GetByID(ctx, id); err != nil {
+		return fmt.Errorf("delete template: %w", err)
+	}
+
+	return t.templateRepo.Delete(ctx, id)
+}
+
+// prepareColumns checks that the columns could be created on one board
+// and fills in the default category.
+func prepareColumns(columns []domain.TemplateColumn) error {
+	names := make(map[string]bool, len(columns))
+	statuses := make(map[string]bool, len(columns))
+	for i := range columns {
+		if names[columns[i].Name] {
+			return fmt.Errorf("column name: %w", domain.ErrUnique)
+		}
+		if statuses[columns[i].Status] {
+			return fmt.Errorf("column status: %w", domain.ErrUnique)
+		}
+		names[columns[i].Name] = true
+		statuses[columns[i].Status] = true
+		if columns[i].Category == "" {
+			columns[i].Category = domain.CategoryTodo
+		}
+	}
+
+	return nil
+}
+
+// prepareLabels checks that the label names are unique and stores no labels as an empty list.
+func prepareLabels(tm *domain.Template) error {
+	if tm.Labels == nil {
+		tm.Labels = []domain.Label{}
+	}
+
+	return domain.CheckLabels(tm.Labels)
+}
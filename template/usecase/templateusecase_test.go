@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/igkostyuk/tasktracker/domain"
+	mocks "github.com/igkostyuk/tasktracker/domain/mock"
+	templateUsecase "github.com/igkostyuk/tasktracker/template/usecase"
+	helper "github.com/matryer/is"
+)
+
+//nolint:exhaustivestruct
+func TestStore(t *testing.T) {
+	tt := []struct {
+		name    string
+		columns []domain.TemplateColumn
+		labels  []domain.Label
+		want    error
+	}{
+		{"success", []domain.TemplateColumn{{Name: "1", Status: "1"}, {Name: "2", Status: "2"}}, nil, nil},
+		{
+			"labels", []domain.TemplateColumn{{Name: "1", Status: "1"}},
+			[]domain.Label{{Name: "bug", Color: "#d73a4a"}, {Name: "feature"}}, nil,
+		},
+		{
+			"duplicate name", []domain.TemplateColumn{{Name: "1", Status: "1"}, {Name: "1", Status: "2"}}, nil,
+			domain.ErrUnique,
+		},
+		{
+			"duplicate status", []domain.TemplateColumn{{Name: "1", Status: "1"}, {Name: "2", Status: "1"}}, nil,
+			domain.ErrUnique,
+		},
+		{
+			"duplicate label", []domain.TemplateColumn{{Name: "1", Status: "1"}},
+			[]domain.Label{{Name: "bug"}, {Name: "bug", Color: "#d73a4a"}}, domain.ErrUnique,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			is := helper.New(t)
+			mt := &mocks.TemplateRepositoryMock{
+				StoreFunc: func(ctx context.Context, tm *domain.Template) error {
+					return nil
+				},
+			}
+			template := domain.Template{Name: "test", Columns: tc.columns, Labels: tc.labels}
+			err := templateUsecase.New(mt).Store(context.TODO(), &template)
+			is.True(errors.Is(err, tc.want))
+			if tc.want != nil {
+				is.Equal(len(mt.StoreCalls()), 0)
+
+				return
+			}
+			is.Equal(len(mt.StoreCalls()), 1)
+			for _, c := range template.Columns {
+				is.Equal(c.Category, domain.CategoryTodo)
+			}
+			is.Equal(len(template.Labels), len(tc.labels))
+			is.True(template.Labels != nil)
+		})
+	}
+}
+
+//nolint:exhaustivestruct
+func TestUpdate(t *testing.T) {
+	is := helper.New(t)
+
+	mt := &mocks.TemplateRepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+			return domain.Template{}, domain.ErrNotFound
+		},
+	}
+	template := domain.Template{ID: uuid.New(), Name: "test", Columns: []domain.TemplateColumn{{Name: "1", Status: "1"}}}
+	err := templateUsecase.New(mt).Update(context.TODO(), &template)
+	is.True(errors.Is(err, domain.ErrNotFound))
+	is.Equal(len(mt.UpdateCalls()), 0)
+}